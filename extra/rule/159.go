@@ -14,6 +14,9 @@ func (r Rule159[D, A]) Name() string {
 }
 
 func (r Rule159[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	if err := checkOneBased(sb, "rule 159"); err != nil {
+		return err
+	}
 	if sb.Size() != 9 {
 		return errors.New("rule 159 only works with 9x9 sudoku")
 	}
@@ -2,6 +2,7 @@ package rule
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/lumaraf/sudoku-solver/rule"
 	"github.com/lumaraf/sudoku-solver/sudoku"
@@ -11,7 +12,23 @@ var (
 	ErrInvalidAreaSum = errors.New("invalid area sum")
 )
 
+// KillerCageRulesFromString creates killer cages from a grid of cage labels (A-Z) and their sums. Cages without a sum
+// only need unique digits, see KillerCageRulesFromClues for other kinds of totals.
 func KillerCageRulesFromString[D sudoku.Digits[D], A sudoku.Area[A]](grid []string, sums map[rune]int) sudoku.Rules[D, A] {
+	cages := parseCageGrid(grid)
+	rules := make(sudoku.Rules[D, A], 0, len(cages))
+	for cageLabel, locations := range cages {
+		cage := KillerCageRule[D, A]{Area: locations}
+		if sum, ok := sums[cageLabel]; ok {
+			cage.Sum = &sum
+		}
+		rules = append(rules, cage)
+	}
+	return rules
+}
+
+// parseCageGrid collects the cells of each cage label (A-Z) in the grid.
+func parseCageGrid(grid []string) map[rune][]sudoku.CellLocation {
 	cages := make(map[rune][]sudoku.CellLocation)
 	for row, rowContent := range grid {
 		for col, cellContent := range rowContent {
@@ -24,31 +41,45 @@ func KillerCageRulesFromString[D sudoku.Digits[D], A sudoku.Area[A]](grid []stri
 			})
 		}
 	}
+	return cages
+}
 
-	rules := make(sudoku.Rules[D, A], 0, len(cages))
-	for cageLabel, locations := range cages {
-		rules = append(rules, KillerCageRule[D, A]{
-			Area: locations,
-			Sum:  sums[cageLabel],
-		})
+// checkOneBased returns an error if the digits of the builder don't count from 1, which the clues of the rule assume.
+func checkOneBased[D sudoku.Digits[D], A sudoku.Area[A]](sb sudoku.SudokuBuilder[D, A], name string) error {
+	if !sb.Alphabet().OneBased() {
+		return fmt.Errorf("%w: %s needs digits that count from 1", sudoku.ErrUnsupportedAlphabet, name)
 	}
-	return rules
+	return nil
 }
 
+// KillerCageRule defines a cage of unique digits. If Sum is set, the digits add up to it. Otherwise, Min and Max bound
+// the total if either of them is set. A cage without any of them only needs unique digits.
 type KillerCageRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
 	Area []sudoku.CellLocation
-	Sum  int
+	Sum  *int
+	Min  *int
+	Max  *int
 }
 
 func (r KillerCageRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
 	area := sb.NewArea(r.Area...)
-	return sb.Use(
+	rules := sudoku.Rules[D, A]{
 		rule.NewUniqueAreaRule[D, A]("killer cage", area),
-		AreaSumRule[D, A]{
+	}
+	switch {
+	case r.Sum != nil:
+		rules = append(rules, AreaSumRule[D, A]{
 			Area: r.Area,
-			Sum:  r.Sum,
-		},
-	)
+			Sum:  *r.Sum,
+		})
+	case r.Min != nil || r.Max != nil:
+		rules = append(rules, AreaSumRangeRule[D, A]{
+			Area: r.Area,
+			Min:  r.Min,
+			Max:  r.Max,
+		})
+	}
+	return sb.Use(rules...)
 }
 
 type AreaSumRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
@@ -58,17 +89,21 @@ type AreaSumRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
 
 func (r AreaSumRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
 	area := sb.NewArea(r.Area...)
+	sum := sb.Alphabet().SumValue(r.Sum, area.Count())
+	if sum < area.Count() || sum > area.Count()*sb.Size() {
+		return fmt.Errorf("%w: %d cells can't add up to %d", ErrInvalidAreaSum, area.Count(), r.Sum)
+	}
 	sb.AddRestriction(AreaSumRestriction[D, A]{
 		area: area,
-		sum:  r.Sum,
+		sum:  sum,
 	})
 	sb.AddValidator(AreaSumValidator[D, A]{
 		area: area,
-		sum:  r.Sum,
+		sum:  sum,
 	})
 	sb.AddSolveProcessor(AreaSumSolveProcessor[D, A]{
 		area: area,
-		sum:  r.Sum,
+		sum:  sum,
 	})
 	return nil
 }
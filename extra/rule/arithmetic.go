@@ -0,0 +1,292 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+var (
+	ErrInvalidArithmeticCage = errors.New("invalid arithmetic cage")
+)
+
+// Operator is the operation that combines the digits of an arithmetic cage.
+type Operator rune
+
+const (
+	OperatorAdd      Operator = '+'
+	OperatorSubtract Operator = '-'
+	OperatorMultiply Operator = '*'
+	OperatorDivide   Operator = '/'
+	// OperatorUnknown matches cages where any of the operators leads to the value.
+	OperatorUnknown Operator = '?'
+)
+
+// ArithmeticCageRulesFromString creates arithmetic cages from a grid of cage labels like KillerCageRulesFromString.
+// Each clue is a value followed by the operator, e.g. "12+", "2/" or "3x". Clues without an operator use
+// OperatorUnknown.
+func ArithmeticCageRulesFromString[D sudoku.Digits[D], A sudoku.Area[A]](grid []string, clues map[rune]string) (sudoku.Rules[D, A], error) {
+	cages := parseCageGrid(grid)
+	rules := make(sudoku.Rules[D, A], 0, len(cages))
+	for cageLabel, locations := range cages {
+		clue, ok := clues[cageLabel]
+		if !ok {
+			return nil, fmt.Errorf("%w: cage %c has no clue", ErrInvalidArithmeticCage, cageLabel)
+		}
+		value, op, err := parseArithmeticClue(clue)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, ArithmeticCageRule[D, A]{
+			Area:     locations,
+			Operator: op,
+			Value:    value,
+		})
+	}
+	return rules, nil
+}
+
+func parseArithmeticClue(clue string) (int, Operator, error) {
+	clue = strings.TrimSpace(clue)
+	op := OperatorUnknown
+	if clue != "" {
+		switch last := clue[len(clue)-1]; last {
+		case '+', '-', '*', '/', '?':
+			op = Operator(last)
+			clue = clue[:len(clue)-1]
+		case 'x', 'X':
+			op = OperatorMultiply
+			clue = clue[:len(clue)-1]
+		}
+	}
+	value, err := strconv.Atoi(clue)
+	if err != nil || value < 0 {
+		return 0, op, fmt.Errorf("%w: %q is not a valid clue", ErrInvalidArithmeticCage, clue)
+	}
+	return value, op, nil
+}
+
+// the digits of a cage combine to the value using the operator. Subtraction and division are only possible for cages
+// with two cells, the smaller digit is subtracted from or divides the larger one. Digits may repeat within a cage unless
+// other rules prevent it.
+type ArithmeticCageRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	Area     []sudoku.CellLocation
+	Operator Operator
+	Value    int
+}
+
+func (r ArithmeticCageRule[D, A]) Name() string {
+	return "arithmetic cage"
+}
+
+func (r ArithmeticCageRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	if err := checkOneBased(sb, "arithmetic cage"); err != nil {
+		return err
+	}
+	area := sb.NewArea(r.Area...)
+	if area.Count() != len(r.Area) || len(r.Area) == 0 {
+		return fmt.Errorf("%w: cage must contain distinct cells", ErrInvalidArithmeticCage)
+	}
+	switch r.Operator {
+	case OperatorAdd, OperatorMultiply, OperatorUnknown:
+	case OperatorSubtract, OperatorDivide:
+		if len(r.Area) != 2 {
+			return fmt.Errorf("%w: operator %c needs two cells", ErrInvalidArithmeticCage, r.Operator)
+		}
+	default:
+		return fmt.Errorf("%w: unknown operator %c", ErrInvalidArithmeticCage, r.Operator)
+	}
+
+	combinations := arithmeticCombinations(sb.Size(), len(r.Area), r.Operator, r.Value)
+	if len(combinations) == 0 {
+		return fmt.Errorf("%w: no digits combine to %d%c", ErrInvalidArithmeticCage, r.Value, r.Operator)
+	}
+
+	c := arithmeticCage{
+		cells:        r.Area,
+		combinations: combinations,
+	}
+	sb.AddRestriction(ArithmeticCageRestriction[D, A]{
+		arithmeticCage: c,
+		area:           area,
+		operator:       r.Operator,
+		value:          r.Value,
+	})
+	sb.AddValidator(ArithmeticCageValidator[D, A]{c})
+	sb.AddChangeProcessor(ArithmeticCageChangeProcessor[D, A]{
+		arithmeticCage: c,
+		area:           area,
+	})
+	return nil
+}
+
+// arithmeticCombinations returns all sorted combinations of count digits that combine to the value. Digits may repeat.
+func arithmeticCombinations(size, count int, op Operator, value int) [][]int {
+	combinations := make([][]int, 0)
+	values := make([]int, 0, count)
+	var build func(start int)
+	build = func(start int) {
+		if len(values) == count {
+			if arithmeticMatches(op, values, value) {
+				combinations = append(combinations, append([]int(nil), values...))
+			}
+			return
+		}
+		for v := start; v <= size; v++ {
+			values = append(values, v)
+			build(v)
+			values = values[:len(values)-1]
+		}
+	}
+	build(1)
+	return combinations
+}
+
+// arithmeticMatches checks the sorted values against the value of a cage.
+func arithmeticMatches(op Operator, values []int, value int) bool {
+	switch op {
+	case OperatorAdd:
+		sum := 0
+		for _, v := range values {
+			sum += v
+		}
+		return sum == value
+	case OperatorMultiply:
+		product := 1
+		for _, v := range values {
+			product *= v
+		}
+		return product == value
+	case OperatorSubtract:
+		return len(values) == 2 && values[1]-values[0] == value
+	case OperatorDivide:
+		return len(values) == 2 && values[1] == values[0]*value
+	case OperatorUnknown:
+		if len(values) == 1 {
+			return values[0] == value
+		}
+		for _, op := range []Operator{OperatorAdd, OperatorSubtract, OperatorMultiply, OperatorDivide} {
+			if arithmeticMatches(op, values, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type arithmeticCage struct {
+	cells        []sudoku.CellLocation
+	combinations [][]int
+}
+
+// placeableDigits places each combination on the cells of the cage and returns the digits each cell has in at least one
+// placement. Cells that see each other can't get the same digit.
+func placeableDigits[D sudoku.Digits[D], A sudoku.Area[A]](s sudoku.Sudoku[D, A], c arithmeticCage) []D {
+	digits := make([]D, len(c.cells))
+	values := make([]int, len(c.cells))
+	var place func(n int, remaining []int) bool
+	place = func(n int, remaining []int) bool {
+		if n == len(c.cells) {
+			for i, v := range values {
+				digits[i] = digits[i].With(v)
+			}
+			return true
+		}
+		l := c.cells[n]
+		placed := false
+		for i, v := range remaining {
+			if i > 0 && remaining[i-1] == v || !s.Get(l).CanContain(v) {
+				continue
+			}
+			conflict := false
+			for m := range n {
+				if values[m] == v && s.GetExclusionArea(l).Get(c.cells[m]) {
+					conflict = true
+					break
+				}
+			}
+			if conflict {
+				continue
+			}
+			values[n] = v
+			next := append(append(make([]int, 0, len(remaining)-1), remaining[:i]...), remaining[i+1:]...)
+			if place(n+1, next) {
+				placed = true
+			}
+		}
+		return placed
+	}
+	for _, combination := range c.combinations {
+		place(0, combination)
+	}
+	return digits
+}
+
+type ArithmeticCageRestriction[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	arithmeticCage
+	area     A
+	operator Operator
+	value    int
+}
+
+func (r ArithmeticCageRestriction[D, A]) Name() string {
+	return "ArithmeticCageRestriction"
+}
+
+func (r ArithmeticCageRestriction[D, A]) Area() A {
+	return r.area
+}
+
+func (r ArithmeticCageRestriction[D, A]) Operator() Operator {
+	return r.operator
+}
+
+func (r ArithmeticCageRestriction[D, A]) Value() int {
+	return r.value
+}
+
+// Combinations returns all sorted combinations of digits that match the clue of the cage.
+func (r ArithmeticCageRestriction[D, A]) Combinations() [][]int {
+	return r.combinations
+}
+
+type ArithmeticCageValidator[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	arithmeticCage
+}
+
+func (v ArithmeticCageValidator[D, A]) Name() string {
+	return "ArithmeticCageValidator"
+}
+
+func (v ArithmeticCageValidator[D, A]) Validate(s sudoku.Sudoku[D, A]) error {
+	if placeableDigits(s, v.arithmeticCage)[0].Empty() {
+		return ErrInvalidArithmeticCage
+	}
+	return nil
+}
+
+// ArithmeticCageChangeProcessor removes all digits that aren't part of a placeable combination.
+type ArithmeticCageChangeProcessor[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	arithmeticCage
+	area A
+}
+
+func (cp ArithmeticCageChangeProcessor[D, A]) Name() string {
+	return "ArithmeticCageChangeProcessor"
+}
+
+func (cp ArithmeticCageChangeProcessor[D, A]) ProcessChanges(s sudoku.Sudoku[D, A]) error {
+	if cp.area.And(s.ChangedArea()).Empty() {
+		return nil
+	}
+
+	for n, d := range placeableDigits(s, cp.arithmeticCage) {
+		if err := s.Mask(cp.cells[n], d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+var (
+	ErrInvalidArrow = errors.New("invalid arrow")
+)
+
+// ArrowRulesFromPaths creates an arrow for every path. The cells of the circle are separated from the shaft by a colon,
+// e.g. "r1c1: r1c2 r1c3" or "r1c1 r1c2: r2c3 r3c3" for a two cell pill. See ParseCellPath for the cell format.
+func ArrowRulesFromPaths[D sudoku.Digits[D], A sudoku.Area[A]](paths ...string) (sudoku.Rules[D, A], error) {
+	rules := make(sudoku.Rules[D, A], 0, len(paths))
+	for _, path := range paths {
+		circlePath, shaftPath, ok := strings.Cut(path, ":")
+		if !ok {
+			return nil, fmt.Errorf("%w: missing colon after the circle in %q", ErrInvalidCellPath, path)
+		}
+		circle, err := ParseCellPath(circlePath)
+		if err != nil {
+			return nil, err
+		}
+		shaft, err := ParseCellPath(shaftPath)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, ArrowRule[D, A]{Circle: circle, Shaft: shaft})
+	}
+	return rules, nil
+}
+
+// the digits on the shaft sum to the number in the circle. A circle of two cells (a pill) is read from left to right or
+// top to bottom as a two digit number, whatever the order of the cells. Pills need grids with single digit numbers.
+type ArrowRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	Circle []sudoku.CellLocation
+	Shaft  []sudoku.CellLocation
+}
+
+func (r ArrowRule[D, A]) Name() string {
+	return "arrow"
+}
+
+func (r ArrowRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	if err := checkOneBased(sb, "arrow"); err != nil {
+		return err
+	}
+	if len(r.Circle) < 1 || len(r.Circle) > 2 {
+		return fmt.Errorf("%w: circle has %d cells", ErrInvalidArrow, len(r.Circle))
+	}
+	if len(r.Circle) == 2 && sb.Size() > 9 {
+		return fmt.Errorf("%w: pills can't be read with more than 9 digits", ErrInvalidArrow)
+	}
+	if err := checkPath(sb.Size(), r.Circle); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidArrow, err)
+	}
+	if err := checkPath(sb.Size(), r.Shaft); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidArrow, err)
+	}
+	circleArea := sb.NewArea(r.Circle...)
+	shaftArea := sb.NewArea(r.Shaft...)
+	if !circleArea.And(shaftArea).Empty() {
+		return fmt.Errorf("%w: circle and shaft overlap", ErrInvalidArrow)
+	}
+	if sb.NewAreaFromOffsets(r.Shaft[0], kingOffsets).And(circleArea).Empty() {
+		return fmt.Errorf("%w: shaft doesn't start at the circle", ErrInvalidArrow)
+	}
+
+	// the tens digit comes first
+	circle := slices.Clone(r.Circle)
+	slices.SortFunc(circle, func(l1, l2 sudoku.CellLocation) int {
+		if l1.Row != l2.Row {
+			return l1.Row - l2.Row
+		}
+		return l1.Col - l2.Col
+	})
+
+	a := arrow[D, A]{
+		circle: circle,
+		shaft:  shaftArea,
+		area:   circleArea.Or(shaftArea),
+	}
+	sb.AddRestriction(ArrowRestriction[D, A]{a})
+	sb.AddValidator(ArrowValidator[D, A]{a})
+	sb.AddChangeProcessor(ArrowChangeProcessor[D, A]{a})
+	return nil
+}
+
+type arrow[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	circle []sudoku.CellLocation
+	shaft  A
+	area   A
+}
+
+// CircleValues yields every number the circle can show, together with the digits of the circle cells.
+func (a arrow[D, A]) CircleValues(s sudoku.Sudoku[D, A]) func(yield func(int, []int) bool) {
+	return func(yield func(int, []int) bool) {
+		if len(a.circle) == 1 {
+			for v := range s.Get(a.circle[0]).Values {
+				if !yield(v, []int{v}) {
+					return
+				}
+			}
+			return
+		}
+		for tens := range s.Get(a.circle[0]).Values {
+			for ones := range s.Get(a.circle[1]).Values {
+				if !yield(tens*10+ones, []int{tens, ones}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// shaftRange returns the lowest and highest possible sum of the shaft.
+func (a arrow[D, A]) shaftRange(s sudoku.Sudoku[D, A]) (int, int) {
+	low, high := 0, 0
+	for _, l := range a.shaft.Locations {
+		d := s.Get(l)
+		low += d.Min()
+		high += d.Max()
+	}
+	return low, high
+}
+
+type ArrowRestriction[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	arrow[D, A]
+}
+
+func (r ArrowRestriction[D, A]) Name() string {
+	return "ArrowRestriction"
+}
+
+// Circle returns the cells of the circle, starting with the most significant digit.
+func (r ArrowRestriction[D, A]) Circle() []sudoku.CellLocation {
+	return r.circle
+}
+
+func (r ArrowRestriction[D, A]) Shaft() A {
+	return r.shaft
+}
+
+func (r ArrowRestriction[D, A]) Area() A {
+	return r.area
+}
+
+type ArrowValidator[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	arrow[D, A]
+}
+
+func (v ArrowValidator[D, A]) Name() string {
+	return "ArrowValidator"
+}
+
+func (v ArrowValidator[D, A]) Validate(s sudoku.Sudoku[D, A]) error {
+	low, high := v.shaftRange(s)
+	for value := range v.CircleValues(s) {
+		if value >= low && value <= high {
+			return nil
+		}
+	}
+	return ErrInvalidArrow
+}
+
+// ArrowChangeProcessor limits the circle to the possible sums of the shaft and the shaft cells to the possible numbers
+// in the circle.
+type ArrowChangeProcessor[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	arrow[D, A]
+}
+
+func (cp ArrowChangeProcessor[D, A]) Name() string {
+	return "ArrowChangeProcessor"
+}
+
+func (cp ArrowChangeProcessor[D, A]) ProcessChanges(s sudoku.Sudoku[D, A]) error {
+	if cp.area.And(s.ChangedArea()).Empty() {
+		return nil
+	}
+
+	low, high := cp.shaftRange(s)
+	circleLow, circleHigh := high+1, low-1
+	masks := make([]D, len(cp.circle))
+	for value, digits := range cp.CircleValues(s) {
+		if value < low || value > high {
+			continue
+		}
+		circleLow = min(circleLow, value)
+		circleHigh = max(circleHigh, value)
+		for n, v := range digits {
+			masks[n] = masks[n].With(v)
+		}
+	}
+	for n, l := range cp.circle {
+		if err := s.Mask(l, masks[n]); err != nil {
+			return err
+		}
+	}
+
+	for _, l := range cp.shaft.Locations {
+		d := s.Get(l)
+		othersLow, othersHigh := low-d.Min(), high-d.Max()
+		if err := s.Mask(l, sudoku.DigitRange[D](s, circleLow-othersHigh, circleHigh-othersLow)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+var (
+	ErrInvalidBetweenLine = errors.New("invalid between line")
+)
+
+// BetweenLineRulesFromPaths creates a between line for every path. The first and last cell of each path are the
+// circles, see ParseCellPath for the format.
+func BetweenLineRulesFromPaths[D sudoku.Digits[D], A sudoku.Area[A]](paths ...string) (sudoku.Rules[D, A], error) {
+	rules := make(sudoku.Rules[D, A], 0, len(paths))
+	for _, path := range paths {
+		cells, err := ParseCellPath(path)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, BetweenLineRule[D, A]{Path: cells})
+	}
+	return rules, nil
+}
+
+// the digits on the line lie strictly between the digits in the circles at both ends of the line.
+type BetweenLineRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	Path []sudoku.CellLocation
+}
+
+func (r BetweenLineRule[D, A]) Name() string {
+	return "between line"
+}
+
+func (r BetweenLineRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	if err := checkPath(sb.Size(), r.Path); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidBetweenLine, err)
+	}
+	if len(r.Path) < 3 {
+		return fmt.Errorf("%w: the line needs at least one cell between the circles", ErrInvalidBetweenLine)
+	}
+
+	b := betweenLine[D, A]{
+		first: r.Path[0],
+		last:  r.Path[len(r.Path)-1],
+		line:  r.Path[1 : len(r.Path)-1],
+		area:  sb.NewArea(r.Path...),
+	}
+	sb.AddRestriction(BetweenLineRestriction[D, A]{b})
+	sb.AddValidator(BetweenLineValidator[D, A]{b})
+	sb.AddChangeProcessor(BetweenLineChangeProcessor[D, A]{b})
+	return nil
+}
+
+type betweenLine[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	first sudoku.CellLocation
+	last  sudoku.CellLocation
+	line  []sudoku.CellLocation
+	area  A
+}
+
+// possibleDigits returns the digits of both circles and of the cells on the line that are part of a valid combination
+// of circle digits.
+func (b betweenLine[D, A]) possibleDigits(s sudoku.Sudoku[D, A]) (first, last, line D) {
+	for v1 := range s.Get(b.first).Values {
+	next:
+		for v2 := range s.Get(b.last).Values {
+			between := sudoku.DigitRange[D](s, min(v1, v2)+1, max(v1, v2)-1)
+			if between.Empty() {
+				continue
+			}
+			for _, l := range b.line {
+				if s.Get(l).And(between).Empty() {
+					continue next
+				}
+			}
+			first = first.With(v1)
+			last = last.With(v2)
+			line = line.Or(between)
+		}
+	}
+	return
+}
+
+type BetweenLineRestriction[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	betweenLine[D, A]
+}
+
+func (r BetweenLineRestriction[D, A]) Name() string {
+	return "BetweenLineRestriction"
+}
+
+// Circles returns the cells at both ends of the line.
+func (r BetweenLineRestriction[D, A]) Circles() (sudoku.CellLocation, sudoku.CellLocation) {
+	return r.first, r.last
+}
+
+// Line returns the cells between the circles.
+func (r BetweenLineRestriction[D, A]) Line() []sudoku.CellLocation {
+	return r.line
+}
+
+func (r BetweenLineRestriction[D, A]) Area() A {
+	return r.area
+}
+
+type BetweenLineValidator[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	betweenLine[D, A]
+}
+
+func (v BetweenLineValidator[D, A]) Name() string {
+	return "BetweenLineValidator"
+}
+
+func (v BetweenLineValidator[D, A]) Validate(s sudoku.Sudoku[D, A]) error {
+	if first, _, _ := v.possibleDigits(s); first.Empty() {
+		return ErrInvalidBetweenLine
+	}
+	return nil
+}
+
+// BetweenLineChangeProcessor removes all digits that aren't part of a valid combination of circle digits.
+type BetweenLineChangeProcessor[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	betweenLine[D, A]
+}
+
+func (cp BetweenLineChangeProcessor[D, A]) Name() string {
+	return "BetweenLineChangeProcessor"
+}
+
+func (cp BetweenLineChangeProcessor[D, A]) ProcessChanges(s sudoku.Sudoku[D, A]) error {
+	if cp.area.And(s.ChangedArea()).Empty() {
+		return nil
+	}
+
+	first, last, line := cp.possibleDigits(s)
+	if err := s.Mask(cp.first, first); err != nil {
+		return err
+	}
+	if err := s.Mask(cp.last, last); err != nil {
+		return err
+	}
+	for _, l := range cp.line {
+		if err := s.Mask(l, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,6 +1,21 @@
 package rule
 
-import "github.com/lumaraf/sudoku-solver/sudoku"
+import (
+	"fmt"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+var kingOffsets = sudoku.Offsets{
+	{Row: -1, Col: -1},
+	{Row: -1, Col: 0},
+	{Row: -1, Col: 1},
+	{Row: 0, Col: -1},
+	{Row: 0, Col: 1},
+	{Row: 1, Col: -1},
+	{Row: 1, Col: 0},
+	{Row: 1, Col: 1},
+}
 
 type AntiKingRule[D sudoku.Digits[D], A sudoku.Area[A]] struct{}
 
@@ -10,16 +25,7 @@ func (r AntiKingRule[D, A]) Name() string {
 
 func (r AntiKingRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
 	return sb.Use(RelativeExclusionRule[D, A]{
-		offsets: sudoku.Offsets{
-			{Row: -1, Col: -1},
-			{Row: -1, Col: 0},
-			{Row: -1, Col: 1},
-			{Row: 0, Col: -1},
-			{Row: 0, Col: 1},
-			{Row: 1, Col: -1},
-			{Row: 1, Col: 0},
-			{Row: 1, Col: 1},
-		},
+		offsets: kingOffsets,
 	})
 }
 
@@ -55,8 +61,32 @@ func (r RelativeExclusionRule[D, A]) Name() string {
 func (r RelativeExclusionRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
 	for row := 0; row < sb.Size(); row++ {
 		for col := 0; col < sb.Size(); col++ {
-			cell := sudoku.CellLocation{row, col}
-			sb.AddExclusionArea(cell, sb.NewAreaFromOffsets(cell, r.offsets))
+			sb.AddOffsetExclusion(sudoku.CellLocation{Row: row, Col: col}, r.offsets)
+		}
+	}
+	return nil
+}
+
+// AntiQueenRule prevents the given digits from repeating along any diagonal. Other digits may repeat.
+type AntiQueenRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	Digits []int
+}
+
+func (r AntiQueenRule[D, A]) Name() string {
+	return "anti-queen"
+}
+
+func (r AntiQueenRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	for _, v := range r.Digits {
+		if v < 1 || v > sb.Size() {
+			return fmt.Errorf("%w: %d", sudoku.ErrValueOutOfRange, v)
+		}
+		mask := sb.NewDigits(v).Not()
+		for k := 1; k < sb.Size(); k++ {
+			sb.AddOffsetMask(v, sudoku.Offset{Row: k, Col: k}, mask)
+			sb.AddOffsetMask(v, sudoku.Offset{Row: k, Col: -k}, mask)
+			sb.AddOffsetMask(v, sudoku.Offset{Row: -k, Col: k}, mask)
+			sb.AddOffsetMask(v, sudoku.Offset{Row: -k, Col: -k}, mask)
 		}
 	}
 	return nil
@@ -0,0 +1,246 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+var (
+	ErrInvalidClone = errors.New("invalid clone")
+)
+
+// Transform maps the cells of a clone's source area onto its target area.
+type Transform int
+
+const (
+	// Translate moves the source area without turning it.
+	Translate Transform = iota
+	// Rotate90 turns the source area a quarter turn clockwise.
+	Rotate90
+	// Rotate180 turns the source area a half turn.
+	Rotate180
+	// Rotate270 turns the source area a quarter turn counterclockwise.
+	Rotate270
+	// MirrorColumns reflects the source area left to right.
+	MirrorColumns
+	// MirrorRows reflects the source area top to bottom.
+	MirrorRows
+	// MirrorDiagonal reflects the source area along its main diagonal.
+	MirrorDiagonal
+	// MirrorAntiDiagonal reflects the source area along its anti-diagonal.
+	MirrorAntiDiagonal
+)
+
+// apply transforms a cell relative to the top left corner of a bounding box with the given height and width.
+func (t Transform) apply(l sudoku.CellLocation, height, width int) (sudoku.CellLocation, error) {
+	switch t {
+	case Translate:
+		return l, nil
+	case Rotate90:
+		return sudoku.CellLocation{Row: l.Col, Col: height - 1 - l.Row}, nil
+	case Rotate180:
+		return sudoku.CellLocation{Row: height - 1 - l.Row, Col: width - 1 - l.Col}, nil
+	case Rotate270:
+		return sudoku.CellLocation{Row: width - 1 - l.Col, Col: l.Row}, nil
+	case MirrorColumns:
+		return sudoku.CellLocation{Row: l.Row, Col: width - 1 - l.Col}, nil
+	case MirrorRows:
+		return sudoku.CellLocation{Row: height - 1 - l.Row, Col: l.Col}, nil
+	case MirrorDiagonal:
+		return sudoku.CellLocation{Row: l.Col, Col: l.Row}, nil
+	case MirrorAntiDiagonal:
+		return sudoku.CellLocation{Row: width - 1 - l.Col, Col: height - 1 - l.Row}, nil
+	}
+	return sudoku.CellLocation{}, fmt.Errorf("%w: unknown transform %d", ErrInvalidClone, t)
+}
+
+// CloneRuleFromPaths creates a clone of the source area at the target area, see ParseCellPath for the format. The cells
+// don't need to form a path, only the shape of the areas matters.
+func CloneRuleFromPaths[D sudoku.Digits[D], A sudoku.Area[A]](transform Transform, anti bool, source, target string) (CloneRule[D, A], error) {
+	sourceCells, err := ParseCellPath(source)
+	if err != nil {
+		return CloneRule[D, A]{}, err
+	}
+	targetCells, err := ParseCellPath(target)
+	if err != nil {
+		return CloneRule[D, A]{}, err
+	}
+	return CloneRule[D, A]{
+		Source:    sourceCells,
+		Target:    targetCells,
+		Transform: transform,
+		Anti:      anti,
+	}, nil
+}
+
+// CloneRule makes the target area a copy of the source area after applying the transform, so corresponding cells
+// contain the same digit. In anti mode, corresponding cells contain different digits instead.
+type CloneRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	Source    []sudoku.CellLocation
+	Target    []sudoku.CellLocation
+	Transform Transform
+	Anti      bool
+}
+
+func (r CloneRule[D, A]) Name() string {
+	if r.Anti {
+		return "anti-clone"
+	}
+	return "clone"
+}
+
+func (r CloneRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	pairs, err := r.pairs(sb.Size())
+	if err != nil {
+		return err
+	}
+
+	if r.Anti {
+		for _, p := range pairs {
+			sb.AddExclusionArea(p[0], sb.NewArea(p[1]))
+			sb.AddExclusionArea(p[1], sb.NewArea(p[0]))
+		}
+		return nil
+	}
+
+	sb.AddRestriction(CloneRestriction[D, A]{
+		pairs: pairs,
+		area:  sb.NewArea(r.Source...).Or(sb.NewArea(r.Target...)),
+	})
+	sb.AddValidator(CloneValidator[D, A]{
+		pairs: pairs,
+	})
+	sb.AddChangeProcessor(CloneChangeProcessor[D, A]{
+		pairs: pairs,
+	})
+	return nil
+}
+
+// pairs matches each source cell with its target cell.
+func (r CloneRule[D, A]) pairs(size int) ([][2]sudoku.CellLocation, error) {
+	if len(r.Source) != len(r.Target) {
+		return nil, fmt.Errorf("%w: areas of %d and %d cells", ErrInvalidClone, len(r.Source), len(r.Target))
+	}
+	if err := checkCells(size, r.Source); err != nil {
+		return nil, err
+	}
+	if err := checkCells(size, r.Target); err != nil {
+		return nil, err
+	}
+
+	sourceOrigin, height, width := boundingBox(r.Source)
+	targetOrigin, _, _ := boundingBox(r.Target)
+	targets := make(map[sudoku.CellLocation]bool, len(r.Target))
+	for _, l := range r.Target {
+		targets[l] = true
+	}
+
+	pairs := make([][2]sudoku.CellLocation, 0, len(r.Source))
+	for _, l := range r.Source {
+		t, err := r.Transform.apply(sudoku.CellLocation{Row: l.Row - sourceOrigin.Row, Col: l.Col - sourceOrigin.Col}, height, width)
+		if err != nil {
+			return nil, err
+		}
+		t = sudoku.CellLocation{Row: t.Row + targetOrigin.Row, Col: t.Col + targetOrigin.Col}
+		if !targets[t] {
+			return nil, fmt.Errorf("%w: areas don't have the same shape", ErrInvalidClone)
+		}
+		if t == l {
+			if r.Anti {
+				return nil, fmt.Errorf("%w: cell %d,%d is its own anti-clone", ErrInvalidClone, l.Row, l.Col)
+			}
+			continue
+		}
+		pairs = append(pairs, [2]sudoku.CellLocation{l, t})
+	}
+	return pairs, nil
+}
+
+// checkCells makes sure that all cells are inside the grid and used only once.
+func checkCells(size int, cells []sudoku.CellLocation) error {
+	seen := make(map[sudoku.CellLocation]bool, len(cells))
+	for _, l := range cells {
+		if l.Row < 0 || l.Row >= size || l.Col < 0 || l.Col >= size {
+			return fmt.Errorf("%w: cell %d,%d is outside of the grid", ErrInvalidClone, l.Row, l.Col)
+		}
+		if seen[l] {
+			return fmt.Errorf("%w: cell %d,%d is used twice", ErrInvalidClone, l.Row, l.Col)
+		}
+		seen[l] = true
+	}
+	return nil
+}
+
+// boundingBox returns the top left corner, height and width of the smallest rectangle containing all cells.
+func boundingBox(cells []sudoku.CellLocation) (sudoku.CellLocation, int, int) {
+	minRow, minCol := cells[0].Row, cells[0].Col
+	maxRow, maxCol := minRow, minCol
+	for _, l := range cells[1:] {
+		minRow, maxRow = min(minRow, l.Row), max(maxRow, l.Row)
+		minCol, maxCol = min(minCol, l.Col), max(maxCol, l.Col)
+	}
+	return sudoku.CellLocation{Row: minRow, Col: minCol}, maxRow - minRow + 1, maxCol - minCol + 1
+}
+
+type CloneRestriction[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	pairs [][2]sudoku.CellLocation
+	area  A
+}
+
+func (r CloneRestriction[D, A]) Name() string {
+	return "CloneRestriction"
+}
+
+// Pairs returns the corresponding cells of the source and target area.
+func (r CloneRestriction[D, A]) Pairs() [][2]sudoku.CellLocation {
+	return r.pairs
+}
+
+func (r CloneRestriction[D, A]) Area() A {
+	return r.area
+}
+
+type CloneValidator[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	pairs [][2]sudoku.CellLocation
+}
+
+func (v CloneValidator[D, A]) Name() string {
+	return "CloneValidator"
+}
+
+func (v CloneValidator[D, A]) Validate(s sudoku.Sudoku[D, A]) error {
+	for _, p := range v.pairs {
+		if s.Get(p[0]).And(s.Get(p[1])).Empty() {
+			return ErrInvalidClone
+		}
+	}
+	return nil
+}
+
+// CloneChangeProcessor keeps the candidates of corresponding cells equal in both directions.
+type CloneChangeProcessor[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	pairs [][2]sudoku.CellLocation
+}
+
+func (cp CloneChangeProcessor[D, A]) Name() string {
+	return "CloneChangeProcessor"
+}
+
+func (cp CloneChangeProcessor[D, A]) ProcessChanges(s sudoku.Sudoku[D, A]) error {
+	changed := s.ChangedArea()
+	for _, p := range cp.pairs {
+		if changed.Get(p[0]) {
+			if err := s.Mask(p[1], s.Get(p[0])); err != nil {
+				return err
+			}
+		}
+		if changed.Get(p[1]) {
+			if err := s.Mask(p[0], s.Get(p[1])); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+var (
+	ErrInvalidEdge = errors.New("invalid edge")
+)
+
+// Edge is the border between two orthogonally adjacent cells.
+type Edge struct {
+	A sudoku.CellLocation
+	B sudoku.CellLocation
+}
+
+// parseEdgeGrid reads markers from a grid with a column between each pair of cells and a row between each pair of rows.
+// Markers in the rows of cells belong to the edge between their left and right neighbour, markers in the rows between
+// the cells belong to the edge between their upper and lower neighbour. All other positions are ignored, so they can be
+// used to draw the cells.
+func parseEdgeGrid(rows []string, marker func(r rune, e Edge)) {
+	for row, rowContent := range rows {
+		for col, r := range []rune(rowContent) {
+			switch {
+			case row%2 == 0 && col%2 == 1:
+				marker(r, Edge{
+					A: sudoku.CellLocation{Row: row / 2, Col: col / 2},
+					B: sudoku.CellLocation{Row: row / 2, Col: col/2 + 1},
+				})
+			case row%2 == 1 && col%2 == 0:
+				marker(r, Edge{
+					A: sudoku.CellLocation{Row: row / 2, Col: col / 2},
+					B: sudoku.CellLocation{Row: row/2 + 1, Col: col / 2},
+				})
+			}
+		}
+	}
+}
+
+// allEdges returns the edges between all orthogonally adjacent cells of the grid.
+func allEdges(size int) []Edge {
+	edges := make([]Edge, 0, 2*size*(size-1))
+	for row := 0; row < size; row++ {
+		for col := 0; col < size; col++ {
+			l := sudoku.CellLocation{Row: row, Col: col}
+			if col+1 < size {
+				edges = append(edges, Edge{A: l, B: sudoku.CellLocation{Row: row, Col: col + 1}})
+			}
+			if row+1 < size {
+				edges = append(edges, Edge{A: l, B: sudoku.CellLocation{Row: row + 1, Col: col}})
+			}
+		}
+	}
+	return edges
+}
+
+func checkEdge(size int, e Edge) error {
+	if err := checkPath(size, []sudoku.CellLocation{e.A, e.B}); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidEdge, err)
+	}
+	if abs(e.A.Row-e.B.Row)+abs(e.A.Col-e.B.Col) != 1 {
+		return fmt.Errorf("%w: cells %d,%d and %d,%d aren't orthogonally adjacent", ErrInvalidEdge, e.A.Row, e.A.Col, e.B.Row, e.B.Col)
+	}
+	return nil
+}
+
+// addPairRelation restricts both cells to digits that are related to a digit in the other cell.
+func addPairRelation[D sudoku.Digits[D], A sudoku.Area[A]](sb sudoku.SudokuBuilder[D, A], l1, l2 sudoku.CellLocation, related func(v1, v2 int) bool) {
+	for v1 := 1; v1 <= sb.Size(); v1++ {
+		mask1, mask2 := sb.NewDigits(), sb.NewDigits()
+		for v2 := 1; v2 <= sb.Size(); v2++ {
+			if related(v1, v2) {
+				mask1 = mask1.With(v2)
+			}
+			if related(v2, v1) {
+				mask2 = mask2.With(v2)
+			}
+		}
+		sb.AddPairMask(v1, l1, l2, mask1)
+		sb.AddPairMask(v1, l2, l1, mask2)
+	}
+}
+
+// addEdgeMarkers relates the cells of all marked edges. In negative mode, the cells of all edges without a marker must
+// not be related by any of the marker relations.
+func addEdgeMarkers[D sudoku.Digits[D], A sudoku.Area[A]](sb sudoku.SudokuBuilder[D, A], markers map[Edge]func(v1, v2 int) bool, negative bool, relations ...func(v1, v2 int) bool) error {
+	for e, related := range markers {
+		if err := checkEdge(sb.Size(), e); err != nil {
+			return err
+		}
+		addPairRelation(sb, e.A, e.B, related)
+	}
+	if !negative {
+		return nil
+	}
+	for _, e := range allEdges(sb.Size()) {
+		if _, ok := markers[e]; ok {
+			continue
+		}
+		if _, ok := markers[Edge{A: e.B, B: e.A}]; ok {
+			continue
+		}
+		addPairRelation(sb, e.A, e.B, func(v1, v2 int) bool {
+			for _, related := range relations {
+				if related(v1, v2) {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	return nil
+}
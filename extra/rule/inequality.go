@@ -0,0 +1,167 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+var (
+	ErrInvalidInequality = errors.New("invalid inequality")
+)
+
+// InequalityRuleFromString reads comparison signs from an edge grid, see parseEdgeGrid. Signs between cells of a row
+// are < and >, signs between cells of a column are ^ (the upper cell is smaller) and v (the upper cell is larger).
+func InequalityRuleFromString[D sudoku.Digits[D], A sudoku.Area[A]](rows ...string) InequalityRule[D, A] {
+	r := InequalityRule[D, A]{}
+	parseEdgeGrid(rows, func(marker rune, e Edge) {
+		switch marker {
+		case '<', '^':
+			r.Less = append(r.Less, e)
+		case '>', 'v':
+			r.Less = append(r.Less, Edge{A: e.B, B: e.A})
+		}
+	})
+	return r
+}
+
+// the digit in cell A of each edge is smaller than the digit in cell B.
+type InequalityRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	Less []Edge
+}
+
+func (r InequalityRule[D, A]) Name() string {
+	return "inequality"
+}
+
+func (r InequalityRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	larger := make(map[sudoku.CellLocation][]sudoku.CellLocation)
+	for _, e := range r.Less {
+		if err := checkEdge(sb.Size(), e); err != nil {
+			return err
+		}
+		larger[e.A] = append(larger[e.A], e.B)
+	}
+	if err := checkAcyclic(larger); err != nil {
+		return err
+	}
+
+	area := sb.NewArea()
+	for _, e := range r.Less {
+		area = area.With(e.A).With(e.B)
+	}
+	sb.AddRestriction(InequalityRestriction[D, A]{
+		less: r.Less,
+		area: area,
+	})
+	sb.AddValidator(InequalityValidator[D, A]{
+		less: r.Less,
+	})
+	sb.AddChangeProcessor(InequalityChangeProcessor[D, A]{
+		less: r.Less,
+		area: area,
+	})
+	return nil
+}
+
+// checkAcyclic makes sure that no cell is required to be larger than itself.
+func checkAcyclic(larger map[sudoku.CellLocation][]sudoku.CellLocation) error {
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := make(map[sudoku.CellLocation]int, len(larger))
+	var visit func(l sudoku.CellLocation) error
+	visit = func(l sudoku.CellLocation) error {
+		switch state[l] {
+		case visiting:
+			return fmt.Errorf("%w: cell %d,%d is part of a cycle", ErrInvalidInequality, l.Row, l.Col)
+		case done:
+			return nil
+		}
+		state[l] = visiting
+		for _, other := range larger[l] {
+			if err := visit(other); err != nil {
+				return err
+			}
+		}
+		state[l] = done
+		return nil
+	}
+	for l := range larger {
+		if err := visit(l); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type InequalityRestriction[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	less []Edge
+	area A
+}
+
+func (r InequalityRestriction[D, A]) Name() string {
+	return "InequalityRestriction"
+}
+
+// Less returns the edges where cell A is smaller than cell B.
+func (r InequalityRestriction[D, A]) Less() []Edge {
+	return r.less
+}
+
+func (r InequalityRestriction[D, A]) Area() A {
+	return r.area
+}
+
+type InequalityValidator[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	less []Edge
+}
+
+func (v InequalityValidator[D, A]) Name() string {
+	return "InequalityValidator"
+}
+
+func (v InequalityValidator[D, A]) Validate(s sudoku.Sudoku[D, A]) error {
+	for _, e := range v.less {
+		if s.Get(e.A).Min() >= s.Get(e.B).Max() {
+			return ErrInvalidInequality
+		}
+	}
+	return nil
+}
+
+// InequalityChangeProcessor raises the lowest digit of the larger cells and lowers the highest digit of the smaller
+// cells until nothing changes anymore, which propagates the bounds along chains of inequalities.
+type InequalityChangeProcessor[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	less []Edge
+	area A
+}
+
+func (cp InequalityChangeProcessor[D, A]) Name() string {
+	return "InequalityChangeProcessor"
+}
+
+func (cp InequalityChangeProcessor[D, A]) ProcessChanges(s sudoku.Sudoku[D, A]) error {
+	if cp.area.And(s.ChangedArea()).Empty() {
+		return nil
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for _, e := range cp.less {
+			smaller, larger := s.Get(e.A), s.Get(e.B)
+			if err := s.Mask(e.B, sudoku.DigitRange[D](s, smaller.Min()+1, s.Size())); err != nil {
+				return err
+			}
+			if err := s.Mask(e.A, sudoku.DigitRange[D](s, 1, larger.Max()-1)); err != nil {
+				return err
+			}
+			if s.Get(e.A) != smaller || s.Get(e.B) != larger {
+				changed = true
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+package rule
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+// KillerCageRulesFromClues creates killer cages from a grid of cage labels like KillerCageRulesFromString. A clue is
+// either a sum like "15", a bound like "<15" or ">15", or a lower case letter like "a" for an unknown total that all
+// cages with the same letter share. Cages with an empty clue, a "?" or no clue at all only need unique digits.
+func KillerCageRulesFromClues[D sudoku.Digits[D], A sudoku.Area[A]](grid []string, clues map[rune]string) (sudoku.Rules[D, A], error) {
+	cages := parseCageGrid(grid)
+	labels := make([]rune, 0, len(cages))
+	for cageLabel := range cages {
+		labels = append(labels, cageLabel)
+	}
+	slices.Sort(labels)
+
+	rules := make(sudoku.Rules[D, A], 0, len(cages))
+	shared := make(map[rune][][]sudoku.CellLocation)
+	for _, cageLabel := range labels {
+		locations := cages[cageLabel]
+		clue := strings.TrimSpace(clues[cageLabel])
+		cage := KillerCageRule[D, A]{Area: locations}
+		switch {
+		case clue == "" || clue == "?":
+		case len(clue) == 1 && clue[0] >= 'a' && clue[0] <= 'z':
+			shared[rune(clue[0])] = append(shared[rune(clue[0])], locations)
+		case clue[0] == '<' || clue[0] == '>':
+			bound, err := strconv.Atoi(clue[1:])
+			if err != nil {
+				return nil, fmt.Errorf("%w: %q is not a valid clue", ErrInvalidAreaSum, clue)
+			}
+			if clue[0] == '<' {
+				bound--
+				cage.Max = &bound
+			} else {
+				bound++
+				cage.Min = &bound
+			}
+		default:
+			sum, err := strconv.Atoi(clue)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %q is not a valid clue", ErrInvalidAreaSum, clue)
+			}
+			cage.Sum = &sum
+		}
+		rules = append(rules, cage)
+	}
+
+	variables := make([]rune, 0, len(shared))
+	for variable := range shared {
+		variables = append(variables, variable)
+	}
+	slices.Sort(variables)
+	for _, variable := range variables {
+		if len(shared[variable]) > 1 {
+			rules = append(rules, SharedSumRule[D, A]{Areas: shared[variable]})
+		}
+	}
+	return rules, nil
+}
+
+// the digits of an area add up to a total between Min and Max. A bound that isn't set leaves that side open. Digits
+// may repeat. Min and Max use the numbers of the alphabet, so they can differ from the digit values.
+type AreaSumRangeRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	Area []sudoku.CellLocation
+	Min  *int
+	Max  *int
+}
+
+func (r AreaSumRangeRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	area := sb.NewArea(r.Area...)
+	minSum := area.Count()
+	if r.Min != nil {
+		minSum = max(sb.Alphabet().SumValue(*r.Min, area.Count()), minSum)
+	}
+	maxSum := area.Count() * sb.Size()
+	if r.Max != nil {
+		maxSum = min(sb.Alphabet().SumValue(*r.Max, area.Count()), maxSum)
+	}
+	if minSum > maxSum {
+		return fmt.Errorf("%w: %d cells have no total in the range", ErrInvalidAreaSum, area.Count())
+	}
+
+	sb.AddRestriction(AreaSumRangeRestriction[D, A]{
+		area: area,
+		min:  minSum,
+		max:  maxSum,
+	})
+	sb.AddValidator(AreaSumRangeValidator[D, A]{
+		area: area,
+		min:  minSum,
+		max:  maxSum,
+	})
+	sb.AddSolveProcessor(AreaSumRangeSolveProcessor[D, A]{
+		area: area,
+		min:  minSum,
+		max:  maxSum,
+	})
+	return nil
+}
+
+type AreaSumRangeRestriction[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	area A
+	min  int
+	max  int
+}
+
+func (r AreaSumRangeRestriction[D, A]) Name() string {
+	return "AreaSumRangeRestriction"
+}
+
+func (r AreaSumRangeRestriction[D, A]) Area() A {
+	return r.area
+}
+
+// Min returns the smallest possible total.
+func (r AreaSumRangeRestriction[D, A]) Min() int {
+	return r.min
+}
+
+// Max returns the largest possible total.
+func (r AreaSumRangeRestriction[D, A]) Max() int {
+	return r.max
+}
+
+type AreaSumRangeValidator[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	area A
+	min  int
+	max  int
+}
+
+func (v AreaSumRangeValidator[D, A]) Name() string {
+	return "AreaSumRangeValidator"
+}
+
+func (v AreaSumRangeValidator[D, A]) Validate(s sudoku.Sudoku[D, A]) error {
+	areaMin, areaMax := sumRange(s, v.area)
+	if areaMin > v.max || areaMax < v.min {
+		return ErrInvalidAreaSum
+	}
+	return nil
+}
+
+// sumRange returns the smallest and largest total the candidates of the area allow.
+func sumRange[D sudoku.Digits[D], A sudoku.Area[A]](s sudoku.Sudoku[D, A], area A) (int, int) {
+	areaMin := 0
+	areaMax := 0
+	for _, cell := range area.Locations {
+		d := s.Get(cell)
+		areaMin += d.Min()
+		areaMax += d.Max()
+	}
+	return areaMin, areaMax
+}
+
+type AreaSumRangeSolveProcessor[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	area A
+	min  int
+	max  int
+}
+
+func (p AreaSumRangeSolveProcessor[D, A]) Name() string {
+	return "AreaSumRangeSolveProcessor"
+}
+
+func (p AreaSumRangeSolveProcessor[D, A]) ProcessSolve(s sudoku.Sudoku[D, A], cell sudoku.CellLocation, mask D) error {
+	if !p.area.Get(cell) {
+		return nil
+	}
+
+	// if all except for one cell in the area are solved, the last cell has to fit into the remaining range
+	solved := p.area.And(s.SolvedArea())
+	if solved.Count() == p.area.Count()-1 {
+		sum, _ := sumRange(s, solved)
+		unsolved := p.area.And(solved.Not())
+		for _, unsolvedCell := range unsolved.Locations {
+			return s.Mask(unsolvedCell, sudoku.DigitRange[D](s, p.min-sum, p.max-sum))
+		}
+	}
+	return nil
+}
+
+// the digits of each area add up to the same unknown total. Digits may repeat unless other rules prevent it. The totals
+// use the numbers of the alphabet, so areas of different sizes compare correctly with digits that count from 0.
+type SharedSumRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	Areas [][]sudoku.CellLocation
+}
+
+func (r SharedSumRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	if len(r.Areas) < 2 {
+		return fmt.Errorf("%w: a shared total needs at least 2 areas", ErrInvalidAreaSum)
+	}
+
+	areas := make([]A, 0, len(r.Areas))
+	for _, cells := range r.Areas {
+		areas = append(areas, sb.NewArea(cells...))
+	}
+	sb.AddRestriction(SharedSumRestriction[D, A]{
+		areas: areas,
+	})
+	sb.AddValidator(SharedSumValidator[D, A]{
+		areas: areas,
+	})
+	return nil
+}
+
+type SharedSumRestriction[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	areas []A
+}
+
+func (r SharedSumRestriction[D, A]) Name() string {
+	return "SharedSumRestriction"
+}
+
+// Areas returns the areas that share a total.
+func (r SharedSumRestriction[D, A]) Areas() []A {
+	return r.areas
+}
+
+type SharedSumValidator[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	areas []A
+}
+
+func (v SharedSumValidator[D, A]) Name() string {
+	return "SharedSumValidator"
+}
+
+func (v SharedSumValidator[D, A]) Validate(s sudoku.Sudoku[D, A]) error {
+	// the possible totals of all areas have to overlap
+	low, high := 0, -1
+	for n, area := range v.areas {
+		areaMin, areaMax := sumRange(s, area)
+		offset := s.Alphabet().SumValue(0, area.Count())
+		if n == 0 {
+			low, high = areaMin-offset, areaMax-offset
+			continue
+		}
+		low, high = max(low, areaMin-offset), min(high, areaMax-offset)
+	}
+	if low > high {
+		return ErrInvalidAreaSum
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package rule
+
+import (
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+// KropkiRuleFromString reads white (o) and black (*) dots from an edge grid, see parseEdgeGrid.
+func KropkiRuleFromString[D sudoku.Digits[D], A sudoku.Area[A]](negative bool, rows ...string) KropkiRule[D, A] {
+	r := KropkiRule[D, A]{Negative: negative}
+	parseEdgeGrid(rows, func(marker rune, e Edge) {
+		switch marker {
+		case 'o', 'O':
+			r.White = append(r.White, e)
+		case '*':
+			r.Black = append(r.Black, e)
+		}
+	})
+	return r
+}
+
+// the digits of cells separated by a white dot are consecutive, the ones separated by a black dot have a ratio of 2. In
+// negative mode, no other adjacent cells may be consecutive or have a ratio of 2.
+type KropkiRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	White    []Edge
+	Black    []Edge
+	Negative bool
+}
+
+func (r KropkiRule[D, A]) Name() string {
+	return "kropki"
+}
+
+func (r KropkiRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	a := sb.Alphabet()
+	consecutive := func(v1, v2 int) bool { return v1-v2 == 1 || v2-v1 == 1 }
+	double := func(v1, v2 int) bool { return a.Number(v1) == 2*a.Number(v2) || a.Number(v2) == 2*a.Number(v1) }
+
+	markers := make(map[Edge]func(v1, v2 int) bool, len(r.White)+len(r.Black))
+	for _, e := range r.White {
+		markers[e] = consecutive
+	}
+	for _, e := range r.Black {
+		markers[e] = double
+	}
+	return addEdgeMarkers(sb, markers, r.Negative, consecutive, double)
+}
@@ -0,0 +1,309 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+var (
+	ErrInvalidLine = errors.New("invalid line")
+)
+
+// Line describes how the digits along a line relate to each other.
+type Line interface {
+	Name() string
+	// Check returns an error if the line can't be used with the given grid size and number of cells.
+	Check(size, length int) error
+	// Related reports whether the digits v1 and v2 may be placed at the positions i and j of a line with the given
+	// length.
+	Related(size, length, i, j, v1, v2 int) bool
+}
+
+// SetLine is implemented by lines that contain one of a few sets of distinct digits in any order. Their cells form a
+// unique area, and digits that aren't part of a set that still fits on the line are removed.
+type SetLine interface {
+	Line
+	// Sets returns the sets of digits a line with the given length may contain.
+	Sets(size, length int) [][]int
+}
+
+// LineRulesFromPaths creates a line for every path, see ParseCellPath for the format.
+func LineRulesFromPaths[D sudoku.Digits[D], A sudoku.Area[A]](line Line, paths ...string) (sudoku.Rules[D, A], error) {
+	rules := make(sudoku.Rules[D, A], 0, len(paths))
+	for _, path := range paths {
+		cells, err := ParseCellPath(path)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, LineRule[D, A]{Path: cells, Line: line})
+	}
+	return rules, nil
+}
+
+// the digits along the path must follow the relation of the line.
+type LineRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	Path []sudoku.CellLocation
+	Line Line
+}
+
+func (r LineRule[D, A]) Name() string {
+	return r.Line.Name()
+}
+
+func (r LineRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	if err := checkPath(sb.Size(), r.Path); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidLine, err)
+	}
+	if err := r.Line.Check(sb.Size(), len(r.Path)); err != nil {
+		return err
+	}
+
+	// every pair of cells with restricted digits gets pair masks
+	for i := range r.Path {
+		for j := i + 1; j < len(r.Path); j++ {
+			related := func(v1, v2 int) bool {
+				return r.Line.Related(sb.Size(), len(r.Path), i, j, v1, v2)
+			}
+			if !restricts(sb.Size(), related) {
+				continue
+			}
+			addPairRelation(sb, r.Path[i], r.Path[j], related)
+		}
+	}
+
+	sb.AddRestriction(LineRestriction[D, A]{
+		path: r.Path,
+		area: sb.NewArea(r.Path...),
+		line: r.Line,
+	})
+	sb.AddValidator(LineValidator[D, A]{
+		path: r.Path,
+		line: r.Line,
+	})
+	if setLine, ok := r.Line.(SetLine); ok {
+		area := sb.NewArea(r.Path...)
+		for _, l := range r.Path {
+			sb.AddExclusionArea(l, area.Without(l))
+		}
+		sets := make([]D, 0)
+		for _, set := range setLine.Sets(sb.Size(), len(r.Path)) {
+			sets = append(sets, sb.NewDigits(set...))
+		}
+		sb.AddChangeProcessor(SetLineChangeProcessor[D, A]{
+			path: r.Path,
+			area: area,
+			sets: sets,
+		})
+	}
+	return nil
+}
+
+// restricts reports whether there is any pair of digits that isn't related.
+func restricts(size int, related func(v1, v2 int) bool) bool {
+	for v1 := 1; v1 <= size; v1++ {
+		for v2 := 1; v2 <= size; v2++ {
+			if !related(v1, v2) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type LineRestriction[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	path []sudoku.CellLocation
+	area A
+	line Line
+}
+
+func (r LineRestriction[D, A]) Name() string {
+	return "LineRestriction"
+}
+
+// Path returns the cells of the line in order.
+func (r LineRestriction[D, A]) Path() []sudoku.CellLocation {
+	return r.path
+}
+
+func (r LineRestriction[D, A]) Area() A {
+	return r.area
+}
+
+func (r LineRestriction[D, A]) Line() Line {
+	return r.line
+}
+
+// LineValidator checks all pairs of solved cells on the line.
+type LineValidator[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	path []sudoku.CellLocation
+	line Line
+}
+
+func (v LineValidator[D, A]) Name() string {
+	return "LineValidator"
+}
+
+func (v LineValidator[D, A]) Validate(s sudoku.Sudoku[D, A]) error {
+	for i, l1 := range v.path {
+		v1, ok := s.Get(l1).Single()
+		if !ok {
+			continue
+		}
+		for j := i + 1; j < len(v.path); j++ {
+			v2, ok := s.Get(v.path[j]).Single()
+			if !ok {
+				continue
+			}
+			if !v.line.Related(s.Size(), len(v.path), i, j, v1, v2) {
+				return fmt.Errorf("%w: %s", ErrInvalidLine, v.line.Name())
+			}
+		}
+	}
+	return nil
+}
+
+// Whispers require adjacent digits on the line to differ by at least the given difference.
+type Whispers struct {
+	Difference int
+}
+
+// GermanWhispers require adjacent digits to differ by at least 5.
+func GermanWhispers() Whispers {
+	return Whispers{Difference: 5}
+}
+
+// DutchWhispers require adjacent digits to differ by at least 4.
+func DutchWhispers() Whispers {
+	return Whispers{Difference: 4}
+}
+
+func (l Whispers) Name() string {
+	return fmt.Sprintf("whispers %d", l.Difference)
+}
+
+func (l Whispers) Check(size, length int) error {
+	if l.Difference < 1 || l.Difference >= size {
+		return fmt.Errorf("%w: difference %d isn't possible with %d digits", ErrInvalidLine, l.Difference, size)
+	}
+	return nil
+}
+
+func (l Whispers) Related(size, length, i, j, v1, v2 int) bool {
+	if abs(i-j) != 1 {
+		return true
+	}
+	return abs(v1-v2) >= l.Difference
+}
+
+// Renban lines contain a set of consecutive digits in any order.
+type Renban struct{}
+
+func (l Renban) Name() string {
+	return "renban"
+}
+
+func (l Renban) Check(size, length int) error {
+	if length > size {
+		return fmt.Errorf("%w: %d cells don't fit into %d digits", ErrInvalidLine, length, size)
+	}
+	return nil
+}
+
+func (l Renban) Related(size, length, i, j, v1, v2 int) bool {
+	return v1 != v2 && abs(v1-v2) < length
+}
+
+// Sets returns every run of consecutive digits as long as the line.
+func (l Renban) Sets(size, length int) [][]int {
+	sets := make([][]int, 0, size-length+1)
+	for low := 1; low+length-1 <= size; low++ {
+		set := make([]int, 0, length)
+		for v := low; v < low+length; v++ {
+			set = append(set, v)
+		}
+		sets = append(sets, set)
+	}
+	return sets
+}
+
+// Palindrome lines read the same in both directions.
+type Palindrome struct{}
+
+func (l Palindrome) Name() string {
+	return "palindrome"
+}
+
+func (l Palindrome) Check(size, length int) error {
+	return nil
+}
+
+func (l Palindrome) Related(size, length, i, j, v1, v2 int) bool {
+	return i+j != length-1 || v1 == v2
+}
+
+// EntropicLine splits the digits into a low, middle and high group. Each set of three adjacent cells contains a digit
+// of each group.
+type EntropicLine struct{}
+
+func (l EntropicLine) Name() string {
+	return "entropic"
+}
+
+func (l EntropicLine) Check(size, length int) error {
+	if size%3 != 0 {
+		return fmt.Errorf("%w: %d digits can't be split into three groups", ErrInvalidLine, size)
+	}
+	return nil
+}
+
+func (l EntropicLine) Related(size, length, i, j, v1, v2 int) bool {
+	sameGroup := (v1-1)/(size/3) == (v2-1)/(size/3)
+	if (j-i)%3 == 0 {
+		return sameGroup
+	}
+	return !sameGroup
+}
+
+// SetLineChangeProcessor removes all digits that aren't part of a set of the line that still fits on it.
+type SetLineChangeProcessor[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	path []sudoku.CellLocation
+	area A
+	sets []D
+}
+
+func (cp SetLineChangeProcessor[D, A]) Name() string {
+	return "SetLineChangeProcessor"
+}
+
+func (cp SetLineChangeProcessor[D, A]) ProcessChanges(s sudoku.Sudoku[D, A]) error {
+	if cp.area.And(s.ChangedArea()).Empty() {
+		return nil
+	}
+
+	possible := s.NewDigits()
+	for _, set := range cp.sets {
+		// every cell needs a digit from the set and every digit of the set needs a cell
+		available := s.NewDigits()
+		fits := true
+		for _, l := range cp.path {
+			d := s.Get(l).And(set)
+			if d.Empty() {
+				fits = false
+				break
+			}
+			available = available.Or(d)
+		}
+		if fits && available == set {
+			possible = possible.Or(set)
+		}
+	}
+
+	for _, l := range cp.path {
+		if err := s.Mask(l, possible); err != nil {
+			return err
+		}
+	}
+	return nil
+}
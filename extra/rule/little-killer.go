@@ -0,0 +1,88 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+var (
+	ErrInvalidLittleKiller = errors.New("invalid little killer")
+)
+
+var diagonalDirections = map[string]sudoku.Offset{
+	"nw": {Row: -1, Col: -1},
+	"ne": {Row: -1, Col: 1},
+	"sw": {Row: 1, Col: -1},
+	"se": {Row: 1, Col: 1},
+}
+
+// LittleKillerRulesFromStrings creates a little killer for every clue. A clue consists of the position outside of the
+// grid, the direction it points to (nw, ne, sw or se) and the sum, e.g. "r0c3 se 23" for a clue above the third column
+// pointing down to the right.
+func LittleKillerRulesFromStrings[D sudoku.Digits[D], A sudoku.Area[A]](clues ...string) (sudoku.Rules[D, A], error) {
+	rules := make(sudoku.Rules[D, A], 0, len(clues))
+	for _, clue := range clues {
+		parts := strings.Fields(clue)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("%w: %q needs a position, a direction and a sum", ErrInvalidLittleKiller, clue)
+		}
+		position, err := ParseCellPath(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		direction, ok := diagonalDirections[strings.ToLower(parts[1])]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown direction %q", ErrInvalidLittleKiller, parts[1])
+		}
+		sum, err := strconv.Atoi(parts[2])
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid sum %q", ErrInvalidLittleKiller, parts[2])
+		}
+		rules = append(rules, LittleKillerRule[D, A]{
+			Start: sudoku.CellLocation{
+				Row: position[0].Row + direction.Row,
+				Col: position[0].Col + direction.Col,
+			},
+			Direction: direction,
+			Sum:       sum,
+		})
+	}
+	return rules, nil
+}
+
+// the digits along the diagonal from the start cell to the edge of the grid sum to the clue. Digits may repeat.
+type LittleKillerRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	Start     sudoku.CellLocation
+	Direction sudoku.Offset
+	Sum       int
+}
+
+func (r LittleKillerRule[D, A]) Name() string {
+	return "little killer"
+}
+
+func (r LittleKillerRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	inside := func(l sudoku.CellLocation) bool {
+		return l.Row >= 0 && l.Row < sb.Size() && l.Col >= 0 && l.Col < sb.Size()
+	}
+	if abs(r.Direction.Row) != 1 || abs(r.Direction.Col) != 1 {
+		return fmt.Errorf("%w: direction %+v isn't diagonal", ErrInvalidLittleKiller, r.Direction)
+	}
+	clue := sudoku.CellLocation{Row: r.Start.Row - r.Direction.Row, Col: r.Start.Col - r.Direction.Col}
+	if !inside(r.Start) || inside(clue) {
+		return fmt.Errorf("%w: clue at %d,%d isn't next to the grid", ErrInvalidLittleKiller, clue.Row, clue.Col)
+	}
+
+	cells := make([]sudoku.CellLocation, 0, sb.Size())
+	for l := r.Start; inside(l); l = (sudoku.CellLocation{Row: l.Row + r.Direction.Row, Col: l.Col + r.Direction.Col}) {
+		cells = append(cells, l)
+	}
+	return sb.Use(AreaSumRule[D, A]{
+		Area: cells,
+		Sum:  r.Sum,
+	})
+}
@@ -0,0 +1,174 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+var (
+	ErrInvalidMinMax = errors.New("invalid minimum or maximum")
+)
+
+var orthogonalOffsets = sudoku.Offsets{
+	{Row: -1, Col: 0},
+	{Row: 0, Col: -1},
+	{Row: 0, Col: 1},
+	{Row: 1, Col: 0},
+}
+
+// MinMaxRuleFromString reads marked cells from a grid. H marks a maximum and L marks a minimum.
+func MinMaxRuleFromString[D sudoku.Digits[D], A sudoku.Area[A]](rows ...string) MinMaxRule[D, A] {
+	r := MinMaxRule[D, A]{}
+	for row, rowContent := range rows {
+		for col, cellContent := range []rune(rowContent) {
+			switch cellContent {
+			case 'H':
+				r.Maxima = append(r.Maxima, sudoku.CellLocation{Row: row, Col: col})
+			case 'L':
+				r.Minima = append(r.Minima, sudoku.CellLocation{Row: row, Col: col})
+			}
+		}
+	}
+	return r
+}
+
+// the digit of a maximum is larger than the digits of all orthogonally adjacent cells, the digit of a minimum is
+// smaller. Two maxima or two minima can't be adjacent.
+type MinMaxRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	Maxima []sudoku.CellLocation
+	Minima []sudoku.CellLocation
+}
+
+func (r MinMaxRule[D, A]) Name() string {
+	return "min-max"
+}
+
+func (r MinMaxRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	maxima, err := markedCells(sb.Size(), r.Maxima)
+	if err != nil {
+		return err
+	}
+	minima, err := markedCells(sb.Size(), r.Minima)
+	if err != nil {
+		return err
+	}
+
+	less := make([]Edge, 0, 4*(len(r.Maxima)+len(r.Minima)))
+	seen := make(map[Edge]bool)
+	add := func(e Edge) {
+		if !seen[e] {
+			seen[e] = true
+			less = append(less, e)
+		}
+	}
+	for _, l := range r.Maxima {
+		if minima[l] {
+			return fmt.Errorf("%w: cell %d,%d is a minimum and a maximum", ErrInvalidMinMax, l.Row, l.Col)
+		}
+		for n := range neighbours(sb.Size(), l) {
+			if maxima[n] {
+				return fmt.Errorf("%w: maxima %d,%d and %d,%d are adjacent", ErrInvalidMinMax, l.Row, l.Col, n.Row, n.Col)
+			}
+			add(Edge{A: n, B: l})
+		}
+	}
+	for _, l := range r.Minima {
+		for n := range neighbours(sb.Size(), l) {
+			if minima[n] {
+				return fmt.Errorf("%w: minima %d,%d and %d,%d are adjacent", ErrInvalidMinMax, l.Row, l.Col, n.Row, n.Col)
+			}
+			add(Edge{A: l, B: n})
+		}
+	}
+	addComparisons(sb, less)
+	return nil
+}
+
+// FortressRuleFromString reads the fortress cells, marked with F, from a grid.
+func FortressRuleFromString[D sudoku.Digits[D], A sudoku.Area[A]](rows ...string) FortressRule[D, A] {
+	r := FortressRule[D, A]{}
+	for row, rowContent := range rows {
+		for col, cellContent := range []rune(rowContent) {
+			if cellContent == 'F' {
+				r.Cells = append(r.Cells, sudoku.CellLocation{Row: row, Col: col})
+			}
+		}
+	}
+	return r
+}
+
+// the digit of a fortress cell is larger than the digits of all orthogonally adjacent cells outside the fortress.
+type FortressRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	Cells []sudoku.CellLocation
+}
+
+func (r FortressRule[D, A]) Name() string {
+	return "fortress"
+}
+
+func (r FortressRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	fortress, err := markedCells(sb.Size(), r.Cells)
+	if err != nil {
+		return err
+	}
+
+	less := make([]Edge, 0, 4*len(r.Cells))
+	for _, l := range r.Cells {
+		for n := range neighbours(sb.Size(), l) {
+			if !fortress[n] {
+				less = append(less, Edge{A: n, B: l})
+			}
+		}
+	}
+	addComparisons(sb, less)
+	return nil
+}
+
+// markedCells makes sure that all cells are inside the grid and returns them as a set.
+func markedCells(size int, cells []sudoku.CellLocation) (map[sudoku.CellLocation]bool, error) {
+	marked := make(map[sudoku.CellLocation]bool, len(cells))
+	for _, l := range cells {
+		if l.Row < 0 || l.Row >= size || l.Col < 0 || l.Col >= size {
+			return nil, fmt.Errorf("%w: cell %d,%d is outside of the grid", ErrInvalidMinMax, l.Row, l.Col)
+		}
+		marked[l] = true
+	}
+	return marked, nil
+}
+
+// neighbours yields the orthogonally adjacent cells inside the grid.
+func neighbours(size int, l sudoku.CellLocation) func(yield func(sudoku.CellLocation) bool) {
+	return func(yield func(sudoku.CellLocation) bool) {
+		for _, o := range orthogonalOffsets {
+			n := sudoku.CellLocation{Row: l.Row + o.Row, Col: l.Col + o.Col}
+			if n.Row < 0 || n.Row >= size || n.Col < 0 || n.Col >= size {
+				continue
+			}
+			if !yield(n) {
+				return
+			}
+		}
+	}
+}
+
+// addComparisons makes the digit in cell A of each edge smaller than the digit in cell B. The edges are added as
+// inequality signs, so they form chains with the signs of an inequality rule.
+func addComparisons[D sudoku.Digits[D], A sudoku.Area[A]](sb sudoku.SudokuBuilder[D, A], less []Edge) {
+	area := sb.NewArea()
+	for _, e := range less {
+		area = area.With(e.A).With(e.B)
+	}
+	sb.AddRestriction(InequalityRestriction[D, A]{
+		less: less,
+		area: area,
+	})
+	sb.AddValidator(InequalityValidator[D, A]{
+		less: less,
+	})
+	sb.AddChangeProcessor(InequalityChangeProcessor[D, A]{
+		less: less,
+		area: area,
+	})
+}
@@ -0,0 +1,92 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+var (
+	ErrInvalidOutsideClue = errors.New("invalid outside clue")
+)
+
+// OutsideClues are clues outside the grid that apply to a row or column as seen from one side. The clues of each side
+// are ordered from left to right or top to bottom, 0 marks lines without a clue.
+type OutsideClues struct {
+	Top    []int
+	Bottom []int
+	Left   []int
+	Right  []int
+}
+
+// lines calls fn for every clue with the cells of its line, starting at the cell next to the clue.
+func (c OutsideClues) lines(size int, fn func(name string, cells []sudoku.CellLocation, clue int) error) error {
+	if len(c.Top) > size || len(c.Bottom) > size || len(c.Left) > size || len(c.Right) > size {
+		return fmt.Errorf("%w: more clues than lines", ErrInvalidOutsideClue)
+	}
+
+	line := func(name string, start sudoku.CellLocation, step sudoku.Offset, clue int) error {
+		if clue == 0 {
+			return nil
+		}
+		cells := make([]sudoku.CellLocation, 0, size)
+		for n := 0; n < size; n++ {
+			cells = append(cells, sudoku.CellLocation{Row: start.Row + n*step.Row, Col: start.Col + n*step.Col})
+		}
+		return fn(name, cells, clue)
+	}
+	for col, clue := range c.Top {
+		if err := line(fmt.Sprintf("column %d from the top", col+1), sudoku.CellLocation{Row: 0, Col: col}, sudoku.Offset{Row: 1}, clue); err != nil {
+			return err
+		}
+	}
+	for col, clue := range c.Bottom {
+		if err := line(fmt.Sprintf("column %d from the bottom", col+1), sudoku.CellLocation{Row: size - 1, Col: col}, sudoku.Offset{Row: -1}, clue); err != nil {
+			return err
+		}
+	}
+	for row, clue := range c.Left {
+		if err := line(fmt.Sprintf("row %d from the left", row+1), sudoku.CellLocation{Row: row, Col: 0}, sudoku.Offset{Col: 1}, clue); err != nil {
+			return err
+		}
+	}
+	for row, clue := range c.Right {
+		if err := line(fmt.Sprintf("row %d from the right", row+1), sudoku.CellLocation{Row: row, Col: size - 1}, sudoku.Offset{Col: -1}, clue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// outsideLine is a row or column read from the side of its clue.
+type outsideLine[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	cells []sudoku.CellLocation
+	area  A
+	clue  int
+}
+
+// Cells returns the cells of the line, starting at the cell next to the clue.
+func (l outsideLine[D, A]) Cells() []sudoku.CellLocation {
+	return l.cells
+}
+
+func (l outsideLine[D, A]) Area() A {
+	return l.area
+}
+
+func (l outsideLine[D, A]) Clue() int {
+	return l.clue
+}
+
+// solvedValues returns the digits of the line if all cells are solved.
+func (l outsideLine[D, A]) solvedValues(s sudoku.Sudoku[D, A]) ([]int, bool) {
+	if !l.area.And(s.SolvedArea().Not()).Empty() {
+		return nil, false
+	}
+	values := make([]int, len(l.cells))
+	for n, cell := range l.cells {
+		values[n], _ = s.Get(cell).Single()
+	}
+	return values, true
+}
@@ -30,7 +30,7 @@ func (r ParityRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
 	evenValues := make([]int, 0, sb.Size()/2)
 	oddValues := make([]int, 0, sb.Size()/2)
 	for v := 1; v <= sb.Size(); v++ {
-		if v%2 == 0 {
+		if sb.Alphabet().Number(v)%2 == 0 {
 			evenValues = append(evenValues, v)
 		} else {
 			oddValues = append(oddValues, v)
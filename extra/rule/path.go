@@ -0,0 +1,72 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+var (
+	ErrInvalidCellPath = errors.New("invalid cell path")
+)
+
+// ParseCellPath parses a path of cells in row/column notation like "r1c1 r2c2 r2c3". Rows and columns start at 1, cells
+// can be separated by spaces, commas or dashes.
+func ParseCellPath(path string) ([]sudoku.CellLocation, error) {
+	tokens := strings.FieldsFunc(strings.ToLower(path), func(r rune) bool {
+		return r == ' ' || r == ',' || r == '-'
+	})
+	cells := make([]sudoku.CellLocation, 0, len(tokens))
+	for _, token := range tokens {
+		rowPart, colPart, ok := strings.Cut(strings.TrimPrefix(token, "r"), "c")
+		if !ok || !strings.HasPrefix(token, "r") {
+			return nil, fmt.Errorf("%w: %q is not a cell", ErrInvalidCellPath, token)
+		}
+		row, err := strconv.Atoi(rowPart)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q is not a cell", ErrInvalidCellPath, token)
+		}
+		col, err := strconv.Atoi(colPart)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q is not a cell", ErrInvalidCellPath, token)
+		}
+		cells = append(cells, sudoku.CellLocation{Row: row - 1, Col: col - 1})
+	}
+	if len(cells) == 0 {
+		return nil, fmt.Errorf("%w: empty path", ErrInvalidCellPath)
+	}
+	return cells, nil
+}
+
+// checkPath makes sure that all cells of the path are inside the grid, are visited only once and that each cell touches
+// the previous one, either orthogonally or diagonally.
+func checkPath(size int, path []sudoku.CellLocation) error {
+	visited := make(map[sudoku.CellLocation]bool, len(path))
+	for n, l := range path {
+		if l.Row < 0 || l.Row >= size || l.Col < 0 || l.Col >= size {
+			return fmt.Errorf("%w: cell %d,%d is outside of the grid", ErrInvalidCellPath, l.Row, l.Col)
+		}
+		if visited[l] {
+			return fmt.Errorf("%w: cell %d,%d is visited twice", ErrInvalidCellPath, l.Row, l.Col)
+		}
+		visited[l] = true
+		if n == 0 {
+			continue
+		}
+		prev := path[n-1]
+		if abs(l.Row-prev.Row) > 1 || abs(l.Col-prev.Col) > 1 {
+			return fmt.Errorf("%w: cell %d,%d doesn't touch cell %d,%d", ErrInvalidCellPath, l.Row, l.Col, prev.Row, prev.Col)
+		}
+	}
+	return nil
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
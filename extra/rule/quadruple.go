@@ -0,0 +1,257 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+var (
+	ErrInvalidQuadruple = errors.New("invalid quadruple")
+)
+
+// QuadrupleRulesFromStrings creates a quadruple for every clue. A clue consists of the top left cell of the 2x2 block
+// and the digits in the circle, e.g. "r1c1 1134" for a circle between the first two rows and columns. The digits are
+// symbols of the alphabet of the builder, e.g. "r1c1 0AF" for hexadoku. They may also be separated by spaces, then a
+// digit with more than one character is read as a number, e.g. "r1c1 1 10 16".
+func QuadrupleRulesFromStrings[D sudoku.Digits[D], A sudoku.Area[A]](clues ...string) (sudoku.Rules[D, A], error) {
+	rules := make(sudoku.Rules[D, A], 0, len(clues))
+	for _, clue := range clues {
+		parts := strings.Fields(clue)
+		if len(parts) < 2 {
+			return nil, fmt.Errorf("%w: %q needs a position and digits", ErrInvalidQuadruple, clue)
+		}
+		position, err := ParseCellPath(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		symbols := parts[1:]
+		if len(symbols) == 1 {
+			symbols = strings.Split(symbols[0], "")
+		}
+		rules = append(rules, QuadrupleRule[D, A]{
+			Corner:  position[0],
+			symbols: symbols,
+		})
+	}
+	return rules, nil
+}
+
+// the digits of the quadruple appear in the 2x2 block below and to the right of the corner cell. A digit that is listed
+// multiple times appears that many times.
+type QuadrupleRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	Corner sudoku.CellLocation
+	Digits []int
+
+	// symbols are the digits of a clue, parsed with the alphabet when the rule is applied
+	symbols []string
+}
+
+func (r QuadrupleRule[D, A]) Name() string {
+	return "quadruple"
+}
+
+func (r QuadrupleRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	if r.Corner.Row < 0 || r.Corner.Row+1 >= sb.Size() || r.Corner.Col < 0 || r.Corner.Col+1 >= sb.Size() {
+		return fmt.Errorf("%w: corner %d,%d is outside of the grid", ErrInvalidQuadruple, r.Corner.Row, r.Corner.Col)
+	}
+	digits := r.Digits
+	if r.symbols != nil {
+		var err error
+		if digits, err = parseQuadrupleDigits(sb.Alphabet(), r.symbols); err != nil {
+			return err
+		}
+	}
+	if len(digits) == 0 || len(digits) > 4 {
+		return fmt.Errorf("%w: %d digits don't fit into four cells", ErrInvalidQuadruple, len(digits))
+	}
+	counts := make(map[int]int, len(digits))
+	for _, v := range digits {
+		if v < 1 || v > sb.Size() {
+			return fmt.Errorf("%w: invalid digit %d", ErrInvalidQuadruple, v)
+		}
+		counts[v]++
+	}
+
+	q := quadruple[D, A]{
+		area: sb.NewAreaFromOffsets(r.Corner, sudoku.Offsets{
+			{Row: 0, Col: 0},
+			{Row: 0, Col: 1},
+			{Row: 1, Col: 0},
+			{Row: 1, Col: 1},
+		}),
+		counts: counts,
+	}
+	sb.AddRestriction(QuadrupleRestriction[D, A]{q})
+	sb.AddValidator(QuadrupleValidator[D, A]{q})
+	sb.AddChangeProcessor(QuadrupleChangeProcessor[D, A]{q})
+	return nil
+}
+
+// parseQuadrupleDigits reads single symbols with the alphabet and longer ones as numbers.
+func parseQuadrupleDigits(alphabet sudoku.Alphabet, symbols []string) ([]int, error) {
+	digits := make([]int, 0, len(symbols))
+	for _, symbol := range symbols {
+		if runes := []rune(symbol); len(runes) == 1 {
+			v, ok := alphabet.Parse(runes[0])
+			if !ok {
+				return nil, fmt.Errorf("%w: invalid digit %q", ErrInvalidQuadruple, symbol)
+			}
+			digits = append(digits, v)
+			continue
+		}
+		n, err := strconv.Atoi(symbol)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid digit %q", ErrInvalidQuadruple, symbol)
+		}
+		digits = append(digits, alphabet.Value(n))
+	}
+	return digits, nil
+}
+
+type quadruple[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	area   A
+	counts map[int]int
+}
+
+// missing returns how often each digit still has to be placed in the unsolved cells and the unsolved cells.
+func (q quadruple[D, A]) missing(s sudoku.Sudoku[D, A]) (map[int]int, A) {
+	missing := make(map[int]int, len(q.counts))
+	for v, count := range q.counts {
+		missing[v] = count
+	}
+	unsolved := q.area.And(s.SolvedArea().Not())
+	for _, l := range q.area.And(s.SolvedArea()).Locations {
+		if v, ok := s.Get(l).Single(); ok && missing[v] > 0 {
+			missing[v]--
+		}
+	}
+	return missing, unsolved
+}
+
+type QuadrupleRestriction[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	quadruple[D, A]
+}
+
+func (r QuadrupleRestriction[D, A]) Name() string {
+	return "QuadrupleRestriction"
+}
+
+func (r QuadrupleRestriction[D, A]) Area() A {
+	return r.area
+}
+
+// Counts returns how often each digit of the quadruple appears in the block.
+func (r QuadrupleRestriction[D, A]) Counts() map[int]int {
+	return r.counts
+}
+
+type QuadrupleValidator[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	quadruple[D, A]
+}
+
+func (v QuadrupleValidator[D, A]) Name() string {
+	return "QuadrupleValidator"
+}
+
+func (v QuadrupleValidator[D, A]) Validate(s sudoku.Sudoku[D, A]) error {
+	missing, unsolved := v.missing(s)
+	total := 0
+	for value, count := range missing {
+		room := 0
+		for _, l := range unsolved.Locations {
+			if s.Get(l).CanContain(value) {
+				room++
+			}
+		}
+		if room < count {
+			return fmt.Errorf("%w: no room for digit %d", ErrInvalidQuadruple, value)
+		}
+		total += count
+	}
+	if total > unsolved.Count() {
+		return fmt.Errorf("%w: not enough cells for all digits", ErrInvalidQuadruple)
+	}
+	return nil
+}
+
+// QuadrupleChangeProcessor places the missing digits once there are as many cells left for them as there are missing
+// digits.
+type QuadrupleChangeProcessor[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	quadruple[D, A]
+}
+
+func (cp QuadrupleChangeProcessor[D, A]) Name() string {
+	return "QuadrupleChangeProcessor"
+}
+
+func (cp QuadrupleChangeProcessor[D, A]) ProcessChanges(s sudoku.Sudoku[D, A]) error {
+	if cp.area.And(s.ChangedArea()).Empty() {
+		return nil
+	}
+
+	missing, unsolved := cp.missing(s)
+	missingDigits := s.NewDigits()
+	total := 0
+	for v, count := range missing {
+		if count == 0 {
+			continue
+		}
+		missingDigits = missingDigits.With(v)
+		total += count
+
+		// a digit that has exactly as many cells left as it is missing fills these cells
+		room := s.NewArea()
+		for _, l := range unsolved.Locations {
+			if s.Get(l).CanContain(v) {
+				room = room.With(l)
+			}
+		}
+		// a repeated digit can only be placed in cells that don't see enough of the other cells
+		if count > 1 {
+			for _, l := range room.Locations {
+				if room.And(s.GetExclusionArea(l).Not()).Without(l).Count() < count-1 {
+					if err := s.RemoveOption(l, v); err != nil {
+						return err
+					}
+					room = room.Without(l)
+				}
+			}
+		}
+		if room.Count() < count {
+			return fmt.Errorf("%w: no room for digit %d", ErrInvalidQuadruple, v)
+		}
+		if room.Count() == count {
+			for _, l := range room.Locations {
+				if err := s.Set(l, v); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if total == 0 {
+		return nil
+	}
+
+	// when the cells that can contain missing digits are just enough for them, they can't contain other digits
+	room := s.NewArea()
+	for _, l := range unsolved.Locations {
+		if !s.Get(l).And(missingDigits).Empty() {
+			room = room.With(l)
+		}
+	}
+	if room.Count() < total {
+		return fmt.Errorf("%w: not enough cells for all digits", ErrInvalidQuadruple)
+	}
+	if room.Count() == total {
+		for _, l := range room.Locations {
+			if err := s.Mask(l, missingDigits); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
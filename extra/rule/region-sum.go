@@ -0,0 +1,219 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+var (
+	ErrInvalidRegionSumLine = errors.New("invalid region sum line")
+)
+
+// RegionSumLineRulesFromPaths creates a region sum line for every path, see ParseCellPath for the format.
+func RegionSumLineRulesFromPaths[D sudoku.Digits[D], A sudoku.Area[A]](paths ...string) (sudoku.Rules[D, A], error) {
+	rules := make(sudoku.Rules[D, A], 0, len(paths))
+	for _, path := range paths {
+		cells, err := ParseCellPath(path)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, RegionSumLineRule[D, A]{Path: cells})
+	}
+	return rules, nil
+}
+
+// a line is split into segments wherever it crosses a box border. The digits of all segments have the same sum.
+type RegionSumLineRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	Path []sudoku.CellLocation
+}
+
+func (r RegionSumLineRule[D, A]) Name() string {
+	return "region sum line"
+}
+
+func (r RegionSumLineRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	if err := checkOneBased(sb, "region sum line"); err != nil {
+		return err
+	}
+	if err := checkPath(sb.Size(), r.Path); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidRegionSumLine, err)
+	}
+
+	segments := make([][]sudoku.CellLocation, 0)
+	for n, l := range r.Path {
+		if n == 0 || sb.BoxAt(l) != sb.BoxAt(r.Path[n-1]) {
+			segments = append(segments, nil)
+		}
+		segments[len(segments)-1] = append(segments[len(segments)-1], l)
+	}
+	if len(segments) < 2 {
+		return fmt.Errorf("%w: the line doesn't cross a box border", ErrInvalidRegionSumLine)
+	}
+
+	area := sb.NewArea(r.Path...)
+	sb.AddRestriction(RegionSumLineRestriction[D, A]{
+		segments: segments,
+		area:     area,
+	})
+	sb.AddValidator(RegionSumLineValidator[D, A]{
+		segments: segments,
+	})
+	sb.AddChangeProcessor(RegionSumLineChangeProcessor[D, A]{
+		segments: segments,
+		area:     area,
+	})
+	return nil
+}
+
+type RegionSumLineRestriction[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	segments [][]sudoku.CellLocation
+	area     A
+}
+
+func (r RegionSumLineRestriction[D, A]) Name() string {
+	return "RegionSumLineRestriction"
+}
+
+// Segments returns the cells of the line grouped by box.
+func (r RegionSumLineRestriction[D, A]) Segments() [][]sudoku.CellLocation {
+	return r.segments
+}
+
+func (r RegionSumLineRestriction[D, A]) Area() A {
+	return r.area
+}
+
+type RegionSumLineValidator[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	segments [][]sudoku.CellLocation
+}
+
+func (v RegionSumLineValidator[D, A]) Name() string {
+	return "RegionSumLineValidator"
+}
+
+func (v RegionSumLineValidator[D, A]) Validate(s sudoku.Sudoku[D, A]) error {
+	// the ranges of possible sums of all segments need to overlap
+	lowest, highest := 0, s.Size()*s.Size()
+	for _, segment := range v.segments {
+		segmentMin, segmentMax := 0, 0
+		for _, l := range segment {
+			segmentMin += s.Get(l).Min()
+			segmentMax += s.Get(l).Max()
+		}
+		lowest = max(lowest, segmentMin)
+		highest = min(highest, segmentMax)
+	}
+	if lowest > highest {
+		return ErrInvalidRegionSumLine
+	}
+	return nil
+}
+
+// RegionSumLineChangeProcessor finds the sums that are possible for every segment and removes all digits that can't
+// be part of one of these sums.
+type RegionSumLineChangeProcessor[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	segments [][]sudoku.CellLocation
+	area     A
+}
+
+func (cp RegionSumLineChangeProcessor[D, A]) Name() string {
+	return "RegionSumLineChangeProcessor"
+}
+
+func (cp RegionSumLineChangeProcessor[D, A]) ProcessChanges(s sudoku.Sudoku[D, A]) error {
+	if cp.area.And(s.ChangedArea()).Empty() {
+		return nil
+	}
+
+	placements := make([]map[int][]D, len(cp.segments))
+	for n, segment := range cp.segments {
+		placements[n] = segmentPlacements(s, segment)
+	}
+
+	for n, segment := range cp.segments {
+		masks := make([]D, len(segment))
+		for sum, digits := range placements[n] {
+			if !sumPossible(placements, sum) {
+				continue
+			}
+			for i := range segment {
+				masks[i] = masks[i].Or(digits[i])
+			}
+		}
+		for i, l := range segment {
+			if err := s.Mask(l, masks[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func sumPossible[D sudoku.Digits[D]](placements []map[int][]D, sum int) bool {
+	for _, p := range placements {
+		if _, ok := p[sum]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// segmentPlacements collects the digits each cell of the segment can have for every possible sum of the segment. The
+// digits of a segment only have to differ if the box is a unique area, which isn't the case for jigsaw grids or latin
+// squares. Instead of trying every permutation, the states that the cells can reach are followed forwards, and then
+// backwards from the states with each sum.
+func segmentPlacements[D sudoku.Digits[D], A sudoku.Area[A]](s sudoku.Sudoku[D, A], segment []sudoku.CellLocation) map[int][]D {
+	type state struct {
+		used D
+		sum  int
+	}
+	unique := s.IsUniqueArea(s.NewArea(segment...))
+	next := func(st state, v int) state {
+		if unique {
+			st.used = st.used.With(v)
+		}
+		st.sum += v
+		return st
+	}
+
+	reachable := make([]map[state]bool, len(segment)+1)
+	reachable[0] = map[state]bool{{used: s.NewDigits()}: true}
+	for n, l := range segment {
+		reachable[n+1] = make(map[state]bool)
+		for st := range reachable[n] {
+			for v := range s.Get(l).And(st.used.Not()).Values {
+				reachable[n+1][next(st, v)] = true
+			}
+		}
+	}
+
+	placements := make(map[int][]D)
+	for final := range reachable[len(segment)] {
+		if _, ok := placements[final.sum]; ok {
+			continue
+		}
+		alive := make(map[state]bool)
+		for st := range reachable[len(segment)] {
+			if st.sum == final.sum {
+				alive[st] = true
+			}
+		}
+		digits := make([]D, len(segment))
+		for n := len(segment) - 1; n >= 0; n-- {
+			previous := make(map[state]bool)
+			for st := range reachable[n] {
+				for v := range s.Get(segment[n]).And(st.used.Not()).Values {
+					if alive[next(st, v)] {
+						previous[st] = true
+						digits[n] = digits[n].With(v)
+					}
+				}
+			}
+			alive = previous
+		}
+		placements[final.sum] = digits
+	}
+	return placements
+}
@@ -0,0 +1,149 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+var (
+	ErrInvalidSandwich = errors.New("invalid sandwich")
+)
+
+// SandwichNoClue marks rows and columns without a sandwich clue.
+const SandwichNoClue = -1
+
+// the digits between the lowest and the highest digit (1 and 9 on a 9x9 grid) of a row or column sum to the clue.
+type SandwichRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	Rows    []int
+	Columns []int
+}
+
+func (r SandwichRule[D, A]) Name() string {
+	return "sandwich"
+}
+
+func (r SandwichRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	if err := checkOneBased(sb, "sandwich"); err != nil {
+		return err
+	}
+	if len(r.Rows) > sb.Size() || len(r.Columns) > sb.Size() {
+		return fmt.Errorf("%w: more clues than lines", ErrInvalidSandwich)
+	}
+
+	for row, sum := range r.Rows {
+		cells := make([]sudoku.CellLocation, 0, sb.Size())
+		for col := 0; col < sb.Size(); col++ {
+			cells = append(cells, sudoku.CellLocation{Row: row, Col: col})
+		}
+		if err := r.applyLine(sb, fmt.Sprintf("row %d", row+1), cells, sum); err != nil {
+			return err
+		}
+	}
+	for col, sum := range r.Columns {
+		cells := make([]sudoku.CellLocation, 0, sb.Size())
+		for row := 0; row < sb.Size(); row++ {
+			cells = append(cells, sudoku.CellLocation{Row: row, Col: col})
+		}
+		if err := r.applyLine(sb, fmt.Sprintf("column %d", col+1), cells, sum); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r SandwichRule[D, A]) applyLine(sb sudoku.SudokuBuilder[D, A], name string, cells []sudoku.CellLocation, sum int) error {
+	if sum == SandwichNoClue {
+		return nil
+	}
+	// the sandwich can contain all digits except for the crusts
+	maxSum := sb.Size()*(sb.Size()+1)/2 - 1 - sb.Size()
+	if sum < 0 || sum > maxSum {
+		return fmt.Errorf("%w: %s has sum %d", ErrInvalidSandwich, name, sum)
+	}
+
+	sandwich := sandwich[D, A]{
+		cells: cells,
+		area:  sb.NewArea(cells...),
+		sum:   sum,
+	}
+	sb.AddRestriction(SandwichRestriction[D, A]{sandwich})
+	sb.AddValidator(SandwichValidator[D, A]{sandwich})
+	return nil
+}
+
+type sandwich[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	cells []sudoku.CellLocation
+	area  A
+	sum   int
+}
+
+// Crusts yields every pair of cell indices that can hold the lowest and the highest digit.
+func (sw sandwich[D, A]) Crusts(s sudoku.Sudoku[D, A]) func(yield func(int, int) bool) {
+	return func(yield func(int, int) bool) {
+		for low, lowCell := range sw.cells {
+			if !s.Get(lowCell).CanContain(1) {
+				continue
+			}
+			for high, highCell := range sw.cells {
+				if low == high || !s.Get(highCell).CanContain(s.Size()) {
+					continue
+				}
+				if !yield(low, high) {
+					return
+				}
+			}
+		}
+	}
+}
+
+type SandwichRestriction[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	sandwich[D, A]
+}
+
+func (r SandwichRestriction[D, A]) Name() string {
+	return "SandwichRestriction"
+}
+
+// Cells returns the cells of the line in order.
+func (r SandwichRestriction[D, A]) Cells() []sudoku.CellLocation {
+	return r.cells
+}
+
+func (r SandwichRestriction[D, A]) Area() A {
+	return r.area
+}
+
+func (r SandwichRestriction[D, A]) Sum() int {
+	return r.sum
+}
+
+type SandwichValidator[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	sandwich[D, A]
+}
+
+func (v SandwichValidator[D, A]) Name() string {
+	return "SandwichValidator"
+}
+
+func (v SandwichValidator[D, A]) Validate(s sudoku.Sudoku[D, A]) error {
+	crusts := s.NewDigits(1, s.Size())
+	for low, high := range v.Crusts(s) {
+		sumMin, sumMax := 0, 0
+		possible := true
+		for n := min(low, high) + 1; n < max(low, high); n++ {
+			d := s.Get(v.cells[n]).And(crusts.Not())
+			if d.Empty() {
+				possible = false
+				break
+			}
+			sumMin += d.Min()
+			sumMax += d.Max()
+		}
+		if possible && sumMin <= v.sum && sumMax >= v.sum {
+			return nil
+		}
+	}
+	return ErrInvalidSandwich
+}
@@ -0,0 +1,69 @@
+package rule
+
+import (
+	"fmt"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+// the digits are buildings of that height, the clue counts the buildings that are visible from its
+// side. Smaller buildings behind taller ones are hidden.
+type SkyscraperRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	OutsideClues
+}
+
+func (r SkyscraperRule[D, A]) Name() string {
+	return "skyscraper"
+}
+
+func (r SkyscraperRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	return r.lines(sb.Size(), func(name string, cells []sudoku.CellLocation, clue int) error {
+		if clue < 1 || clue > sb.Size() {
+			return fmt.Errorf("%w: %s sees %d skyscrapers", ErrInvalidOutsideClue, name, clue)
+		}
+		line := outsideLine[D, A]{
+			cells: cells,
+			area:  sb.NewArea(cells...),
+			clue:  clue,
+		}
+		sb.AddRestriction(SkyscraperRestriction[D, A]{line})
+		sb.AddValidator(SkyscraperValidator[D, A]{line})
+		return nil
+	})
+}
+
+type SkyscraperRestriction[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	outsideLine[D, A]
+}
+
+func (r SkyscraperRestriction[D, A]) Name() string {
+	return "SkyscraperRestriction"
+}
+
+// VisibleSkyscrapers counts the digits that are larger than all digits before them.
+func VisibleSkyscrapers(values []int) int {
+	visible, highest := 0, 0
+	for _, v := range values {
+		if v > highest {
+			visible++
+			highest = v
+		}
+	}
+	return visible
+}
+
+type SkyscraperValidator[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	outsideLine[D, A]
+}
+
+func (v SkyscraperValidator[D, A]) Name() string {
+	return "SkyscraperValidator"
+}
+
+func (v SkyscraperValidator[D, A]) Validate(s sudoku.Sudoku[D, A]) error {
+	// the partial lines are checked by the skyscraper strategy
+	if values, ok := v.solvedValues(s); ok && VisibleSkyscrapers(values) != v.clue {
+		return ErrInvalidOutsideClue
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+var (
+	ErrInvalidThermo = errors.New("invalid thermometer")
+)
+
+// ThermoRulesFromPaths creates a thermometer for every path. Each path starts at the bulb, see ParseCellPath for the
+// format.
+func ThermoRulesFromPaths[D sudoku.Digits[D], A sudoku.Area[A]](paths ...string) (sudoku.Rules[D, A], error) {
+	rules := make(sudoku.Rules[D, A], 0, len(paths))
+	for _, path := range paths {
+		cells, err := ParseCellPath(path)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, ThermoRule[D, A]{Path: cells})
+	}
+	return rules, nil
+}
+
+// digits strictly increase along the thermometer, starting at the bulb.
+type ThermoRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	Path []sudoku.CellLocation
+}
+
+func (r ThermoRule[D, A]) Name() string {
+	return "thermo"
+}
+
+func (r ThermoRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	if err := checkPath(sb.Size(), r.Path); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidThermo, err)
+	}
+	if len(r.Path) > sb.Size() {
+		return fmt.Errorf("%w: %d cells don't fit into %d digits", ErrInvalidThermo, len(r.Path), sb.Size())
+	}
+
+	area := sb.NewArea(r.Path...)
+	sb.AddRestriction(ThermoRestriction[D, A]{
+		path: r.Path,
+		area: area,
+	})
+	sb.AddValidator(ThermoValidator[D, A]{
+		path: r.Path,
+	})
+	sb.AddChangeProcessor(ThermoChangeProcessor[D, A]{
+		path: r.Path,
+		area: area,
+	})
+	return nil
+}
+
+type ThermoRestriction[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	path []sudoku.CellLocation
+	area A
+}
+
+func (r ThermoRestriction[D, A]) Name() string {
+	return "ThermoRestriction"
+}
+
+// Path returns the cells of the thermometer, starting at the bulb.
+func (r ThermoRestriction[D, A]) Path() []sudoku.CellLocation {
+	return r.path
+}
+
+func (r ThermoRestriction[D, A]) Area() A {
+	return r.area
+}
+
+type ThermoValidator[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	path []sudoku.CellLocation
+}
+
+func (v ThermoValidator[D, A]) Name() string {
+	return "ThermoValidator"
+}
+
+func (v ThermoValidator[D, A]) Validate(s sudoku.Sudoku[D, A]) error {
+	// each cell needs a digit that is larger than the smallest possible digit of the previous cell
+	lowest := 0
+	for _, l := range v.path {
+		d := s.Get(l).And(sudoku.DigitRange[D](s, lowest+1, s.Size()))
+		if d.Empty() {
+			return ErrInvalidThermo
+		}
+		lowest = d.Min()
+	}
+	return nil
+}
+
+// ThermoChangeProcessor propagates the lowest and highest possible digits along the thermometer.
+type ThermoChangeProcessor[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	path []sudoku.CellLocation
+	area A
+}
+
+func (cp ThermoChangeProcessor[D, A]) Name() string {
+	return "ThermoChangeProcessor"
+}
+
+func (cp ThermoChangeProcessor[D, A]) ProcessChanges(s sudoku.Sudoku[D, A]) error {
+	if cp.area.And(s.ChangedArea()).Empty() {
+		return nil
+	}
+
+	lowest := 0
+	for _, l := range cp.path {
+		if err := s.Mask(l, sudoku.DigitRange[D](s, lowest+1, s.Size())); err != nil {
+			return err
+		}
+		lowest = s.Get(l).Min()
+	}
+
+	highest := s.Size() + 1
+	for n := len(cp.path) - 1; n >= 0; n-- {
+		l := cp.path[n]
+		if err := s.Mask(l, sudoku.DigitRange[D](s, 1, highest-1)); err != nil {
+			return err
+		}
+		highest = s.Get(l).Max()
+	}
+	return nil
+}
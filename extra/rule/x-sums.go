@@ -0,0 +1,66 @@
+package rule
+
+import (
+	"fmt"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+// the first X digits of a line sum to the clue, where X is the digit next to the clue.
+type XSumsRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	OutsideClues
+}
+
+func (r XSumsRule[D, A]) Name() string {
+	return "x-sums"
+}
+
+func (r XSumsRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	if err := checkOneBased(sb, "x-sums"); err != nil {
+		return err
+	}
+	return r.lines(sb.Size(), func(name string, cells []sudoku.CellLocation, clue int) error {
+		if clue < 1 || clue > sb.Size()*(sb.Size()+1)/2 {
+			return fmt.Errorf("%w: %s has x-sum %d", ErrInvalidOutsideClue, name, clue)
+		}
+		line := outsideLine[D, A]{
+			cells: cells,
+			area:  sb.NewArea(cells...),
+			clue:  clue,
+		}
+		sb.AddRestriction(XSumRestriction[D, A]{line})
+		sb.AddValidator(XSumValidator[D, A]{line})
+		return nil
+	})
+}
+
+type XSumRestriction[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	outsideLine[D, A]
+}
+
+func (r XSumRestriction[D, A]) Name() string {
+	return "XSumRestriction"
+}
+
+type XSumValidator[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	outsideLine[D, A]
+}
+
+func (v XSumValidator[D, A]) Name() string {
+	return "XSumValidator"
+}
+
+func (v XSumValidator[D, A]) Validate(s sudoku.Sudoku[D, A]) error {
+	// at least one digit in the first cell needs a sum range that contains the clue
+	for x := range s.Get(v.cells[0]).Values {
+		sumMin, sumMax := 0, 0
+		for _, cell := range v.cells[:x] {
+			sumMin += s.Get(cell).Min()
+			sumMax += s.Get(cell).Max()
+		}
+		if sumMin <= v.clue && sumMax >= v.clue {
+			return nil
+		}
+	}
+	return ErrInvalidOutsideClue
+}
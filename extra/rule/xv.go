@@ -0,0 +1,47 @@
+package rule
+
+import (
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+// XVRuleFromString reads X and V markers from an edge grid, see parseEdgeGrid. For a 9x9 grid, the rows have 17
+// characters and there are 17 rows.
+func XVRuleFromString[D sudoku.Digits[D], A sudoku.Area[A]](negative bool, rows ...string) XVRule[D, A] {
+	r := XVRule[D, A]{Negative: negative}
+	parseEdgeGrid(rows, func(marker rune, e Edge) {
+		switch marker {
+		case 'X', 'x':
+			r.X = append(r.X, e)
+		case 'V', 'v':
+			r.V = append(r.V, e)
+		}
+	})
+	return r
+}
+
+// the digits of cells separated by an X sum to 10, the ones separated by a V sum to 5. In negative mode, no other
+// adjacent cells may sum to 5 or 10.
+type XVRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	X        []Edge
+	V        []Edge
+	Negative bool
+}
+
+func (r XVRule[D, A]) Name() string {
+	return "xv"
+}
+
+func (r XVRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	a := sb.Alphabet()
+	sumX := func(v1, v2 int) bool { return a.Number(v1)+a.Number(v2) == 10 }
+	sumV := func(v1, v2 int) bool { return a.Number(v1)+a.Number(v2) == 5 }
+
+	markers := make(map[Edge]func(v1, v2 int) bool, len(r.X)+len(r.V))
+	for _, e := range r.X {
+		markers[e] = sumX
+	}
+	for _, e := range r.V {
+		markers[e] = sumV
+	}
+	return addEdgeMarkers(sb, markers, r.Negative, sumX, sumV)
+}
@@ -0,0 +1,116 @@
+package strategy
+
+import (
+	"github.com/lumaraf/sudoku-solver/extra/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+func AreaSumStrategyFactory[D sudoku.Digits[D], A sudoku.Area[A]](s sudoku.Sudoku[D, A]) []sudoku.Strategy[D, A] {
+	strategies := sudoku.Strategies[D, A]{}
+	for r := range sudoku.GetRestrictions[D, A, rule.AreaSumRestriction[D, A]](s) {
+		// areas with unique digits are handled by the KillerCageStrategy
+		if s.IsUniqueArea(r.Area()) {
+			continue
+		}
+		strategies = append(strategies, AreaSumStrategy[D, A]{
+			area: r.Area(),
+			sum:  r.Sum(),
+		})
+	}
+	return strategies
+}
+
+// AreaSumStrategy searches the digit assignments of areas where digits may repeat, like little killer diagonals. Cells
+// of the area that see each other (e.g. because they share a box) still need different digits.
+type AreaSumStrategy[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	area A
+	sum  int
+}
+
+func (st AreaSumStrategy[D, A]) Name() string {
+	return "AreaSumStrategy"
+}
+
+func (st AreaSumStrategy[D, A]) Difficulty() sudoku.Difficulty {
+	return sudoku.DIFFICULTY_NORMAL
+}
+
+func (st AreaSumStrategy[D, A]) AreaFilter() A {
+	return st.area
+}
+
+func (st AreaSumStrategy[D, A]) Solve(s sudoku.Sudoku[D, A], push func(sudoku.Strategy[D, A])) error {
+	if st.area.And(s.SolvedArea().Not()).Empty() {
+		return nil
+	}
+
+	cells := make([]sudoku.CellLocation, 0, st.area.Count())
+	for _, l := range st.area.Locations {
+		cells = append(cells, l)
+	}
+
+	// every assignment that is found proves all of its digits to be possible
+	possible := make([]D, len(cells))
+	for n, l := range cells {
+		for v := range s.Get(l).And(possible[n].Not()).Values {
+			assignment := make([]int, len(cells))
+			assignment[n] = v
+			if st.assign(s, cells, assignment, 0, st.sum-v) {
+				for i, av := range assignment {
+					possible[i] = possible[i].With(av)
+				}
+			}
+		}
+	}
+
+	for n, l := range cells {
+		if err := s.Mask(l, possible[n]); err != nil {
+			return err
+		}
+	}
+
+	push(st)
+	return nil
+}
+
+// assign fills the unassigned cells starting at index n so that their digits add up to the remaining sum.
+func (st AreaSumStrategy[D, A]) assign(s sudoku.Sudoku[D, A], cells []sudoku.CellLocation, assignment []int, n int, remaining int) bool {
+	if n == len(cells) {
+		return remaining == 0
+	}
+	if assignment[n] != 0 {
+		return st.assign(s, cells, assignment, n+1, remaining)
+	}
+
+	restMin, restMax := 0, 0
+	for i := n + 1; i < len(cells); i++ {
+		if assignment[i] == 0 {
+			d := s.Get(cells[i])
+			restMin += d.Min()
+			restMax += d.Max()
+		}
+	}
+
+	exclusionArea := s.GetExclusionArea(cells[n])
+	for v := range s.Get(cells[n]).Values {
+		if remaining-v < restMin || remaining-v > restMax {
+			continue
+		}
+		conflict := false
+		for i, av := range assignment {
+			if av == v && exclusionArea.Get(cells[i]) {
+				conflict = true
+				break
+			}
+		}
+		if conflict {
+			continue
+		}
+		assignment[n] = v
+		if st.assign(s, cells, assignment, n+1, remaining-v) {
+			return true
+		}
+		assignment[n] = 0
+	}
+	return false
+}
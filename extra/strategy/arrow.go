@@ -0,0 +1,101 @@
+package strategy
+
+import (
+	"errors"
+
+	"github.com/lumaraf/sudoku-solver/extra/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+func ArrowStrategyFactory[D sudoku.Digits[D], A sudoku.Area[A]](s sudoku.Sudoku[D, A]) []sudoku.Strategy[D, A] {
+	var allMasks map[int][]D
+
+	strategies := sudoku.Strategies[D, A]{}
+	for r := range sudoku.GetRestrictions[D, A, rule.ArrowRestriction[D, A]](s) {
+		// digits may repeat on shafts that aren't unique, so the combinations of a killer cage don't apply
+		if !s.IsUniqueArea(r.Shaft()) {
+			continue
+		}
+
+		if allMasks == nil {
+			allMasks = generateAreaSumMasks(s)
+		}
+		strategies = append(strategies, ArrowStrategy[D, A]{
+			restriction: r,
+			allMasks:    allMasks,
+		})
+	}
+	return strategies
+}
+
+// ArrowStrategy enumerates the digit combinations of shafts with unique digits for all numbers the circle can show,
+// like the KillerCageStrategy does for a fixed sum.
+type ArrowStrategy[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	restriction rule.ArrowRestriction[D, A]
+	allMasks    map[int][]D
+}
+
+func (st ArrowStrategy[D, A]) Name() string {
+	return "ArrowStrategy"
+}
+
+func (st ArrowStrategy[D, A]) Difficulty() sudoku.Difficulty {
+	return sudoku.DIFFICULTY_NORMAL
+}
+
+func (st ArrowStrategy[D, A]) AreaFilter() A {
+	return st.restriction.Area()
+}
+
+func (st ArrowStrategy[D, A]) Solve(s sudoku.Sudoku[D, A], push func(sudoku.Strategy[D, A])) error {
+	if st.restriction.Area().And(s.SolvedArea().Not()).Empty() {
+		return nil
+	}
+
+	shaft := st.restriction.Shaft()
+	cage := KillerCageStrategy[D, A]{
+		area:  shaft,
+		masks: make([]D, 0),
+	}
+
+	// find the numbers in the circle that have a placeable combination on the shaft
+	placeable := map[int]bool{}
+	for value := range st.restriction.CircleValues(s) {
+		if _, ok := placeable[value]; ok {
+			continue
+		}
+		placeable[value] = false
+		for _, m := range st.allMasks[value] {
+			if m.Count() == shaft.Count() && isMaskPlaceable(s, shaft, m) {
+				placeable[value] = true
+				cage.masks = append(cage.masks, m)
+			}
+		}
+	}
+	if len(cage.masks) == 0 {
+		return errors.New("no valid masks for arrow")
+	}
+
+	circle := st.restriction.Circle()
+	masks := make([]D, len(circle))
+	for value, digits := range st.restriction.CircleValues(s) {
+		if !placeable[value] {
+			continue
+		}
+		for n, v := range digits {
+			masks[n] = masks[n].With(v)
+		}
+	}
+	for n, l := range circle {
+		if err := s.Mask(l, masks[n]); err != nil {
+			return err
+		}
+	}
+
+	if err := cage.Solve(s, func(sudoku.Strategy[D, A]) {}); err != nil {
+		return err
+	}
+
+	push(st)
+	return nil
+}
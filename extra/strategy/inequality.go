@@ -0,0 +1,44 @@
+package strategy
+
+import (
+	"github.com/lumaraf/sudoku-solver/extra/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+func InequalityStrategyFactory[D sudoku.Digits[D], A sudoku.Area[A]](s sudoku.Sudoku[D, A]) []sudoku.Strategy[D, A] {
+	larger := map[sudoku.CellLocation]A{}
+	area := s.NewArea()
+	for r := range sudoku.GetRestrictions[D, A, rule.InequalityRestriction[D, A]](s) {
+		for _, e := range r.Less() {
+			larger[e.A] = larger[e.A].With(e.B)
+		}
+		area = area.Or(r.Area())
+	}
+	if area.Empty() {
+		return nil
+	}
+	return []sudoku.Strategy[D, A]{InequalityStrategy[D, A]{newOrderStrategy(s, area, larger)}}
+}
+
+// InequalityStrategy follows chains of inequality signs. A cell has to be smaller than the longest chain of cells that
+// are larger than it, and other larger cells that need distinct digits push it further down. The same applies to the
+// smaller cells. Minima and maxima add their comparisons as inequality signs, so they are part of the same chains.
+type InequalityStrategy[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	orderStrategy[D, A]
+}
+
+func (st InequalityStrategy[D, A]) Name() string {
+	return "InequalityStrategy"
+}
+
+func (st InequalityStrategy[D, A]) Difficulty() sudoku.Difficulty {
+	return sudoku.DIFFICULTY_NORMAL
+}
+
+func (st InequalityStrategy[D, A]) Solve(s sudoku.Sudoku[D, A], push func(sudoku.Strategy[D, A])) error {
+	if err := st.limit(s); err != nil {
+		return err
+	}
+	push(st)
+	return nil
+}
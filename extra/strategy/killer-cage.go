@@ -8,30 +8,58 @@ import (
 )
 
 func KillerCageStrategyFactory[D sudoku.Digits[D], A sudoku.Area[A]](s sudoku.Sudoku[D, A]) []sudoku.Strategy[D, A] {
-	allMasks := generateAreaSumMasks(s)
+	var allMasks map[int][]D
 
 	strategies := sudoku.Strategies[D, A]{}
-	for r := range sudoku.GetRestrictions[D, A, rule.AreaSumRestriction[D, A]](s) {
-		if !s.IsUniqueArea(r.Area()) {
+	for _, t := range cageTotals(s) {
+		if !s.IsUniqueArea(t.area) {
 			continue
 		}
 
-		masks := make([]D, 0)
-		for _, m := range allMasks[r.Sum()] {
-			if m.Count() == r.Area().Count() {
-				masks = append(masks, m)
-			}
+		// the masks are only generated on demand, as their number grows exponentially with the grid size
+		if allMasks == nil {
+			allMasks = generateAreaSumMasks(s)
 		}
+
 		strategies = append(strategies, KillerCageStrategy[D, A]{
-			area:  r.Area(),
-			masks: masks,
+			area:  t.area,
+			masks: sumMasks(allMasks, t.area.Count(), t.min, t.max),
 		})
 	}
+	return strategies
+}
+
+// cageTotal is an area whose digits add up to a total between min and max.
+type cageTotal[A sudoku.Area[A]] struct {
+	area A
+	min  int
+	max  int
+}
+
+// cageTotals collects all areas with an exact or bounded total. Areas without a known total are left out. Digits may
+// repeat in the areas, like on little killer diagonals, so users have to check IsUniqueArea before combining digits.
+func cageTotals[D sudoku.Digits[D], A sudoku.Area[A]](s sudoku.Sudoku[D, A]) []cageTotal[A] {
+	totals := make([]cageTotal[A], 0)
+	for r := range sudoku.GetRestrictions[D, A, rule.AreaSumRestriction[D, A]](s) {
+		totals = append(totals, cageTotal[A]{area: r.Area(), min: r.Sum(), max: r.Sum()})
+	}
+	for r := range sudoku.GetRestrictions[D, A, rule.AreaSumRangeRestriction[D, A]](s) {
+		totals = append(totals, cageTotal[A]{area: r.Area(), min: r.Min(), max: r.Max()})
+	}
+	return totals
+}
 
-	if len(strategies) == 0 {
-		return strategies
+// sumMasks returns the combinations of count distinct digits with a total between low and high.
+func sumMasks[D sudoku.Digits[D]](allMasks map[int][]D, count, low, high int) []D {
+	masks := make([]D, 0)
+	for sum := max(low, 1); sum <= high; sum++ {
+		for _, m := range allMasks[sum] {
+			if m.Count() == count {
+				masks = append(masks, m)
+			}
+		}
 	}
-	return strategies
+	return masks
 }
 
 type KillerCageStrategy[D sudoku.Digits[D], A sudoku.Area[A]] struct {
@@ -61,7 +89,7 @@ func (st KillerCageStrategy[D, A]) Solve(s sudoku.Sudoku[D, A], push func(sudoku
 	forcedDigits := s.NewDigits()
 	masks := make([]D, 0, len(st.masks))
 	for _, m := range st.masks {
-		if st.isMaskPlaceable(s, st.area, m) {
+		if isMaskPlaceable(s, st.area, m) {
 			masks = append(masks, m)
 			forcedDigits = forcedDigits.And(m)
 		}
@@ -113,14 +141,15 @@ func (st KillerCageStrategy[D, A]) isValuePlaceable(s sudoku.Sudoku[D, A], l sud
 			return true
 		}
 		m = m.Without(v)
-		if st.isMaskPlaceable(s, area, m) {
+		if isMaskPlaceable(s, area, m) {
 			return true
 		}
 	}
 	return false
 }
 
-func (st KillerCageStrategy[D, A]) isMaskPlaceable(s sudoku.Sudoku[D, A], area A, mask D) bool {
+// isMaskPlaceable reports whether the cells of the area can take distinct digits of the mask.
+func isMaskPlaceable[D sudoku.Digits[D], A sudoku.Area[A]](s sudoku.Sudoku[D, A], area A, mask D) bool {
 	for _, l := range area.Locations {
 		d := s.Get(l)
 		for v := range d.And(mask).Values {
@@ -129,7 +158,7 @@ func (st KillerCageStrategy[D, A]) isMaskPlaceable(s sudoku.Sudoku[D, A], area A
 				return true
 			}
 			nextMask := mask.And(s.NewDigits(v).Not())
-			if st.isMaskPlaceable(s, nextArea, nextMask) {
+			if isMaskPlaceable(s, nextArea, nextMask) {
 				return true
 			}
 		}
@@ -1,35 +1,32 @@
 package strategy
 
 import (
-	extraRule "github.com/lumaraf/sudoku-solver/extra/rule"
 	"github.com/lumaraf/sudoku-solver/rule"
 	"github.com/lumaraf/sudoku-solver/sudoku"
 )
 
 func HiddenKillerCageStrategyFactory[D sudoku.Digits[D], A sudoku.Area[A]](s sudoku.Sudoku[D, A]) []sudoku.Strategy[D, A] {
-	allMasks := generateAreaSumMasks(s)
 	strategies := sudoku.Strategies[D, A]{}
 
-	areaSumRestrictions := make([]extraRule.AreaSumRestriction[D, A], 0)
-	for r := range sudoku.GetRestrictions[D, A, extraRule.AreaSumRestriction[D, A]](s) {
-		areaSumRestrictions = append(areaSumRestrictions, r)
-	}
-
-	if len(areaSumRestrictions) == 0 {
+	totals := cageTotals(s)
+	if len(totals) == 0 {
 		return strategies
 	}
+	allMasks := generateAreaSumMasks(s)
 
 	// find hidden cages
 	knownCages := map[A]bool{}
 	for baseArea := range hiddenCageBaseAreas[D, A](s) {
 		baseSum := (s.Size() * (s.Size() + 1) / 2) * (baseArea.Count() / s.Size())
+		baseMin, baseMax := baseSum, baseSum
 
 		hits := 0
-		for _, r2 := range areaSumRestrictions {
-			if baseArea.Or(r2.Area()).Count() == baseArea.Count() {
+		for _, t := range totals {
+			if baseArea.Or(t.area).Count() == baseArea.Count() {
 				hits++
-				baseArea = baseArea.And(r2.Area().Not())
-				baseSum -= r2.Sum()
+				baseArea = baseArea.And(t.area.Not())
+				baseMin -= t.max
+				baseMax -= t.min
 			}
 		}
 
@@ -38,33 +35,19 @@ func HiddenKillerCageStrategyFactory[D sudoku.Digits[D], A sudoku.Area[A]](s sud
 				continue
 			}
 			knownCages[baseArea] = true
-			//fmt.Printf("Hidden cage found: sum=%d site=%d\n", baseSum, baseArea.Count())
-			masks := make([]D, 0)
-			for _, m := range allMasks[baseSum] {
-				if m.Count() == baseArea.Count() {
-					masks = append(masks, m)
-				}
-			}
 			strategies = append(strategies, KillerCageStrategy[D, A]{
 				area:  baseArea,
-				masks: masks,
+				masks: sumMasks(allMasks, baseArea.Count(), baseMin, baseMax),
 			})
 
-			// check for inverted cage
-			for _, r2 := range areaSumRestrictions {
-				if baseArea.And(r2.Area().Not()).Empty() {
-					area := r2.Area().And(baseArea.Not())
-					if !area.Empty() {
-						masks := make([]D, 0)
-						for _, m := range allMasks[r2.Sum()-baseSum] {
-							if m.Count() == area.Count() {
-								masks = append(masks, m)
-							}
-						}
-
+			// check for inverted cage, the digits of the remaining cells have to be unique to be combined into masks
+			for _, t := range totals {
+				if baseArea.And(t.area.Not()).Empty() {
+					area := t.area.And(baseArea.Not())
+					if !area.Empty() && s.IsUniqueArea(area) {
 						strategies = append(strategies, KillerCageStrategy[D, A]{
 							area:  area,
-							masks: masks,
+							masks: sumMasks(allMasks, area.Count(), t.min-baseMax, t.max-baseMin),
 						})
 					}
 				}
@@ -0,0 +1,120 @@
+package strategy
+
+import (
+	"fmt"
+
+	"github.com/lumaraf/sudoku-solver/extra/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+func SharedSumStrategyFactory[D sudoku.Digits[D], A sudoku.Area[A]](s sudoku.Sudoku[D, A]) []sudoku.Strategy[D, A] {
+	var allMasks map[int][]D
+
+	strategies := sudoku.Strategies[D, A]{}
+	for r := range sudoku.GetRestrictions[D, A, rule.SharedSumRestriction[D, A]](s) {
+		// only cages with unique digits can be described by digit combinations
+		unique := true
+		for _, area := range r.Areas() {
+			unique = unique && s.IsUniqueArea(area)
+		}
+		if !unique {
+			continue
+		}
+
+		if allMasks == nil {
+			allMasks = generateAreaSumMasks(s)
+		}
+
+		st := SharedSumStrategy[D, A]{
+			areas: r.Areas(),
+			area:  s.NewArea(),
+			masks: make([][]D, 0, len(r.Areas())),
+		}
+		for _, area := range r.Areas() {
+			st.area = st.area.Or(area)
+			st.masks = append(st.masks, sumMasks(allMasks, area.Count(), 1, area.Count()*s.Size()))
+		}
+		strategies = append(strategies, st)
+	}
+	return strategies
+}
+
+// SharedSumStrategy intersects the totals that each cage of a shared total can still reach and limits the cages to the
+// digit combinations of the remaining totals.
+type SharedSumStrategy[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	areas []A
+	area  A
+	masks [][]D
+}
+
+func (st SharedSumStrategy[D, A]) Name() string {
+	return "SharedSumStrategy"
+}
+
+func (st SharedSumStrategy[D, A]) Difficulty() sudoku.Difficulty {
+	return sudoku.DIFFICULTY_NORMAL
+}
+
+func (st SharedSumStrategy[D, A]) AreaFilter() A {
+	return st.area
+}
+
+func (st SharedSumStrategy[D, A]) Solve(s sudoku.Sudoku[D, A], push func(sudoku.Strategy[D, A])) error {
+	if st.area.And(s.SolvedArea().Not()).Empty() {
+		return nil
+	}
+
+	var sums map[int]bool
+	placeable := make([][]D, len(st.areas))
+	offsets := make([]int, len(st.areas))
+	for n, area := range st.areas {
+		// totals are compared on the numbers of the alphabet
+		offsets[n] = s.Alphabet().SumValue(0, area.Count())
+		areaSums := make(map[int]bool)
+		for _, m := range st.masks[n] {
+			if isMaskPlaceable(s, area, m) {
+				placeable[n] = append(placeable[n], m)
+				areaSums[maskSum(m)-offsets[n]] = true
+			}
+		}
+		if sums == nil {
+			sums = areaSums
+			continue
+		}
+		for sum := range sums {
+			if !areaSums[sum] {
+				delete(sums, sum)
+			}
+		}
+	}
+	if len(sums) == 0 {
+		return fmt.Errorf("%w: no shared total for killer cages", rule.ErrInvalidAreaSum)
+	}
+
+	for n, area := range st.areas {
+		masks := make([]D, 0, len(placeable[n]))
+		for _, m := range placeable[n] {
+			if sums[maskSum(m)-offsets[n]] {
+				masks = append(masks, m)
+			}
+		}
+		cage := KillerCageStrategy[D, A]{
+			area:  area,
+			masks: masks,
+		}
+		if err := cage.Solve(s, func(sudoku.Strategy[D, A]) {}); err != nil {
+			return err
+		}
+	}
+
+	push(st)
+	return nil
+}
+
+func maskSum[D sudoku.Digits[D]](m D) int {
+	sum := 0
+	for v := range m.Values {
+		sum += v
+	}
+	return sum
+}
@@ -0,0 +1,153 @@
+package strategy
+
+import (
+	"errors"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+// orderStrategy limits the digits of cells that are ordered by a strict relation, like the cells on thermometers or
+// the cells between inequality signs. For each cell, it selects groups of distinct cells that are larger or smaller
+// than it. The longest chain of larger or smaller cells is always part of these groups.
+type orderStrategy[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	area          A
+	largerGroups  map[sudoku.CellLocation]A
+	smallerGroups map[sudoku.CellLocation]A
+}
+
+// newOrderStrategy builds the groups from the cells that are directly known to be larger than each cell.
+func newOrderStrategy[D sudoku.Digits[D], A sudoku.Area[A]](s sudoku.Sudoku[D, A], area A, larger map[sudoku.CellLocation]A) orderStrategy[D, A] {
+	larger = transitiveClosure(larger)
+	smaller := map[sudoku.CellLocation]A{}
+	for l, a := range larger {
+		for _, other := range a.Locations {
+			smaller[other] = smaller[other].With(l)
+		}
+	}
+
+	distinct := func(l1, l2 sudoku.CellLocation) bool {
+		return s.GetExclusionArea(l1).Get(l2) || larger[l1].Get(l2) || larger[l2].Get(l1)
+	}
+	st := orderStrategy[D, A]{
+		area:          area,
+		largerGroups:  map[sudoku.CellLocation]A{},
+		smallerGroups: map[sudoku.CellLocation]A{},
+	}
+	for _, l := range area.Locations {
+		st.largerGroups[l] = distinctGroup(s, longestChain(s, larger[l], larger), larger[l], distinct)
+		st.smallerGroups[l] = distinctGroup(s, longestChain(s, smaller[l], smaller), smaller[l], distinct)
+	}
+	return st
+}
+
+func transitiveClosure[A sudoku.Area[A]](relation map[sudoku.CellLocation]A) map[sudoku.CellLocation]A {
+	closure := make(map[sudoku.CellLocation]A, len(relation))
+	for l, a := range relation {
+		closure[l] = a
+	}
+	for changed := true; changed; {
+		changed = false
+		for l, a := range closure {
+			combined := a
+			for _, other := range a.Locations {
+				combined = combined.Or(closure[other])
+			}
+			if combined != a {
+				closure[l] = combined
+				changed = true
+			}
+		}
+	}
+	return closure
+}
+
+// longestChain returns the longest chain of cells in the area that follow each other in the transitive relation.
+func longestChain[D sudoku.Digits[D], A sudoku.Area[A]](s sudoku.Sudoku[D, A], area A, relation map[sudoku.CellLocation]A) A {
+	chains := map[sudoku.CellLocation]A{}
+	var chain func(l sudoku.CellLocation) A
+	chain = func(l sudoku.CellLocation) A {
+		if c, ok := chains[l]; ok {
+			return c
+		}
+		// mark the cell as visited in case the relation contains a cycle
+		chains[l] = s.NewArea(l)
+		longest := s.NewArea()
+		for _, other := range relation[l].Without(l).Locations {
+			if c := chain(other); c.Count() > longest.Count() {
+				longest = c
+			}
+		}
+		chains[l] = longest.With(l)
+		return chains[l]
+	}
+
+	longest := s.NewArea()
+	for _, l := range area.Locations {
+		if c := chain(l); c.Count() > longest.Count() {
+			longest = c
+		}
+	}
+	return longest
+}
+
+// distinctGroup greedily adds cells of the area to the group that all need different digits.
+func distinctGroup[D sudoku.Digits[D], A sudoku.Area[A]](s sudoku.Sudoku[D, A], group A, area A, distinct func(l1, l2 sudoku.CellLocation) bool) A {
+	for _, l := range area.And(group.Not()).Locations {
+		fits := true
+		for _, member := range group.Locations {
+			if !distinct(l, member) {
+				fits = false
+				break
+			}
+		}
+		if fits {
+			group = group.With(l)
+		}
+	}
+	return group
+}
+
+func (st orderStrategy[D, A]) AreaFilter() A {
+	return st.area
+}
+
+func (st orderStrategy[D, A]) limit(s sudoku.Sudoku[D, A]) error {
+	for _, l := range st.area.And(s.SolvedArea().Not()).Locations {
+		if group := st.largerGroups[l]; !group.Empty() {
+			digits := groupDigits(s, group)
+			if digits.Count() < group.Count() {
+				return errors.New("not enough digits for larger cells")
+			}
+			// the cell has to be smaller than the group.Count()-th largest digit of the group
+			for range group.Count() - 1 {
+				digits = digits.Without(digits.Max())
+			}
+			if err := s.RemoveMask(l, sudoku.DigitRange[D](s, digits.Max(), s.Size())); err != nil {
+				return err
+			}
+		}
+		if group := st.smallerGroups[l]; !group.Empty() {
+			digits := groupDigits(s, group)
+			if digits.Count() < group.Count() {
+				return errors.New("not enough digits for smaller cells")
+			}
+			for range group.Count() - 1 {
+				digits = digits.Without(digits.Min())
+			}
+			// the cell has to be larger than the group.Count()-th smallest digit of the group
+			if err := s.RemoveMask(l, sudoku.DigitRange[D](s, 1, digits.Min())); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// groupDigits returns all digits that are possible in at least one cell of the area.
+func groupDigits[D sudoku.Digits[D], A sudoku.Area[A]](s sudoku.Sudoku[D, A], area A) D {
+	d := s.NewDigits()
+	for _, l := range area.Locations {
+		d = d.Or(s.Get(l))
+	}
+	return d
+}
@@ -0,0 +1,72 @@
+package strategy
+
+import (
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+// lineStep decides after each placed digit whether the placement is still possible and whether the remaining cells
+// are no longer constrained by the clue.
+type lineStep func(values []int) (possible bool, done bool)
+
+// enumerateLine places distinct digits on the cells of a line in order and returns the digits of each cell that are
+// part of a possible placement. The cells after a finished placement only need to be completable, so their digits are
+// not enumerated.
+func enumerateLine[D sudoku.Digits[D], A sudoku.Area[A]](s sudoku.Sudoku[D, A], cells []sudoku.CellLocation, step lineStep) []D {
+	possible := make([]D, len(cells))
+	values := make([]int, 0, len(cells))
+	var place func(used D)
+	place = func(used D) {
+		n := len(values)
+		for v := range s.Get(cells[n]).And(used.Not()).Values {
+			values = append(values, v)
+			ok, done := step(values)
+			switch {
+			case !ok:
+			case done || len(values) == len(cells):
+				rest := cells[len(values):]
+				if isCompletable(s, rest, used.With(v)) {
+					for i, value := range values {
+						possible[i] = possible[i].With(value)
+					}
+					for i, l := range rest {
+						possible[len(values)+i] = possible[len(values)+i].Or(s.Get(l).And(used.With(v).Not()))
+					}
+				}
+			default:
+				place(used.With(v))
+			}
+			values = values[:n]
+		}
+	}
+	place(s.NewDigits())
+	return possible
+}
+
+// isCompletable checks if the cells can get distinct digits that aren't used yet, by searching a matching between
+// cells and digits.
+func isCompletable[D sudoku.Digits[D], A sudoku.Area[A]](s sudoku.Sudoku[D, A], cells []sudoku.CellLocation, used D) bool {
+	owner := make(map[int]int, len(cells))
+	var assign func(cell int, visited D) (D, bool)
+	assign = func(cell int, visited D) (D, bool) {
+		for v := range s.Get(cells[cell]).And(used.Not()).And(visited.Not()).Values {
+			visited = visited.With(v)
+			other, taken := owner[v]
+			if !taken {
+				owner[v] = cell
+				return visited, true
+			}
+			var ok bool
+			if visited, ok = assign(other, visited); ok {
+				owner[v] = cell
+				return visited, true
+			}
+		}
+		return visited, false
+	}
+	for cell := range cells {
+		if _, ok := assign(cell, s.NewDigits()); !ok {
+			return false
+		}
+	}
+	return true
+}
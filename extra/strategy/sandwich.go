@@ -0,0 +1,106 @@
+package strategy
+
+import (
+	"errors"
+
+	"github.com/lumaraf/sudoku-solver/extra/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+func SandwichStrategyFactory[D sudoku.Digits[D], A sudoku.Area[A]](s sudoku.Sudoku[D, A]) []sudoku.Strategy[D, A] {
+	var allMasks map[int][]D
+
+	strategies := sudoku.Strategies[D, A]{}
+	for r := range sudoku.GetRestrictions[D, A, rule.SandwichRestriction[D, A]](s) {
+		if !s.IsUniqueArea(r.Area()) {
+			continue
+		}
+
+		if allMasks == nil {
+			allMasks = generateAreaSumMasks(s)
+		}
+
+		// the crusts can't be part of the sandwich
+		crusts := s.NewDigits(1, s.Size())
+		masks := make([]D, 0)
+		if r.Sum() == 0 {
+			masks = append(masks, s.NewDigits())
+		}
+		for _, m := range allMasks[r.Sum()] {
+			if m.And(crusts).Empty() {
+				masks = append(masks, m)
+			}
+		}
+		strategies = append(strategies, SandwichStrategy[D, A]{
+			restriction: r,
+			masks:       masks,
+		})
+	}
+	return strategies
+}
+
+// SandwichStrategy enumerates all placements of the crusts in a line together with the digit combinations that fit
+// between them.
+type SandwichStrategy[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	restriction rule.SandwichRestriction[D, A]
+	masks       []D
+}
+
+func (st SandwichStrategy[D, A]) Name() string {
+	return "SandwichStrategy"
+}
+
+func (st SandwichStrategy[D, A]) Difficulty() sudoku.Difficulty {
+	return sudoku.DIFFICULTY_NORMAL
+}
+
+func (st SandwichStrategy[D, A]) AreaFilter() A {
+	return st.restriction.Area()
+}
+
+func (st SandwichStrategy[D, A]) Solve(s sudoku.Sudoku[D, A], push func(sudoku.Strategy[D, A])) error {
+	area := st.restriction.Area()
+	if area.And(s.SolvedArea().Not()).Empty() {
+		return nil
+	}
+
+	cells := st.restriction.Cells()
+	crusts := s.NewDigits(1, s.Size())
+	possible := make(map[sudoku.CellLocation]D, len(cells))
+	isPlaceable := func(area A, m D) bool {
+		return area.Empty() || isMaskPlaceable(s, area, m)
+	}
+	for low, high := range st.restriction.Crusts(s) {
+		between := s.NewArea(cells[min(low, high)+1 : max(low, high)]...)
+		outside := area.And(between.Not()).Without(cells[low]).Without(cells[high])
+		for _, m := range st.masks {
+			if m.Count() != between.Count() {
+				continue
+			}
+			rest := m.Or(crusts).Not()
+			if !isPlaceable(between, m) || !isPlaceable(outside, rest) {
+				continue
+			}
+			possible[cells[low]] = possible[cells[low]].With(1)
+			possible[cells[high]] = possible[cells[high]].With(s.Size())
+			for _, l := range between.Locations {
+				possible[l] = possible[l].Or(m)
+			}
+			for _, l := range outside.Locations {
+				possible[l] = possible[l].Or(rest)
+			}
+		}
+	}
+	if len(possible) == 0 {
+		return errors.New("no valid placement for sandwich")
+	}
+
+	for _, l := range cells {
+		if err := s.Mask(l, possible[l]); err != nil {
+			return err
+		}
+	}
+
+	push(st)
+	return nil
+}
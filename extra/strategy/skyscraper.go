@@ -0,0 +1,73 @@
+package strategy
+
+import (
+	"errors"
+
+	"github.com/lumaraf/sudoku-solver/extra/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+func SkyscraperStrategyFactory[D sudoku.Digits[D], A sudoku.Area[A]](s sudoku.Sudoku[D, A]) []sudoku.Strategy[D, A] {
+	strategies := sudoku.Strategies[D, A]{}
+	for r := range sudoku.GetRestrictions[D, A, rule.SkyscraperRestriction[D, A]](s) {
+		if !s.IsUniqueArea(r.Area()) {
+			continue
+		}
+		strategies = append(strategies, SkyscraperStrategy[D, A]{
+			restriction: r,
+		})
+	}
+	return strategies
+}
+
+// SkyscraperStrategy enumerates the digits next to a skyscraper clue, up to the highest digit which hides all
+// following ones.
+type SkyscraperStrategy[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	restriction rule.SkyscraperRestriction[D, A]
+}
+
+func (st SkyscraperStrategy[D, A]) Name() string {
+	return "SkyscraperStrategy"
+}
+
+func (st SkyscraperStrategy[D, A]) Difficulty() sudoku.Difficulty {
+	return sudoku.DIFFICULTY_NORMAL
+}
+
+func (st SkyscraperStrategy[D, A]) AreaFilter() A {
+	return st.restriction.Area()
+}
+
+func (st SkyscraperStrategy[D, A]) Solve(s sudoku.Sudoku[D, A], push func(sudoku.Strategy[D, A])) error {
+	if st.restriction.Area().And(s.SolvedArea().Not()).Empty() {
+		return nil
+	}
+
+	clue := st.restriction.Clue()
+	cells := st.restriction.Cells()
+	possible := enumerateLine(s, cells, func(values []int) (bool, bool) {
+		visible := rule.VisibleSkyscrapers(values)
+		highest := 0
+		for _, v := range values {
+			highest = max(highest, v)
+		}
+		if highest == s.Size() {
+			return visible == clue, true
+		}
+		// each remaining cell can add at most one visible building, up to the number of taller digits
+		remaining := min(len(cells)-len(values), s.Size()-highest)
+		return visible < clue && visible+remaining >= clue, false
+	})
+
+	for n, l := range cells {
+		if possible[n].Empty() {
+			return errors.New("no valid placement for skyscraper")
+		}
+		if err := s.Mask(l, possible[n]); err != nil {
+			return err
+		}
+	}
+
+	push(st)
+	return nil
+}
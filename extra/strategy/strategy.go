@@ -11,5 +11,37 @@ func AllExtraStrategies[D sudoku.Digits[D], A sudoku.Area[A]]() sudoku.StrategyF
 		// HiddenKillerCageStrategy:
 		// Identifies hidden killer cages by analyzing the grid for areas that must sum to specific values based on existing cages.
 		sudoku.StrategyFactoryFunc[D, A](HiddenKillerCageStrategyFactory[D, A]),
+
+		// SharedSumStrategy:
+		// Limits killer cages that share an unknown total to the totals all of them can still reach.
+		sudoku.StrategyFactoryFunc[D, A](SharedSumStrategyFactory[D, A]),
+
+		// AreaSumStrategy:
+		// Searches the possible digits of sum areas where digits may repeat, e.g. little killer diagonals.
+		sudoku.StrategyFactoryFunc[D, A](AreaSumStrategyFactory[D, A]),
+
+		// ThermoStrategy:
+		// Limits the digits on thermometers by the number of distinct digits that have to fit above and below each cell.
+		sudoku.StrategyFactoryFunc[D, A](ThermoStrategyFactory[D, A]),
+
+		// InequalityStrategy:
+		// Bounds the digits between inequality signs, minima and maxima by the longest chains of larger and smaller cells.
+		sudoku.StrategyFactoryFunc[D, A](InequalityStrategyFactory[D, A]),
+
+		// ArrowStrategy:
+		// Combines the possible numbers in an arrow's circle with the digit combinations of its shaft.
+		sudoku.StrategyFactoryFunc[D, A](ArrowStrategyFactory[D, A]),
+
+		// SandwichStrategy:
+		// Enumerates the placements of the lowest and highest digit in a line and the digits that can be sandwiched between them.
+		sudoku.StrategyFactoryFunc[D, A](SandwichStrategyFactory[D, A]),
+
+		// XSumStrategy:
+		// Enumerates the digits next to an x-sum clue, up to the digit that gives their count.
+		sudoku.StrategyFactoryFunc[D, A](XSumStrategyFactory[D, A]),
+
+		// SkyscraperStrategy:
+		// Enumerates the digits next to a skyscraper clue, up to the highest digit which hides all following ones.
+		sudoku.StrategyFactoryFunc[D, A](SkyscraperStrategyFactory[D, A]),
 	}
 }
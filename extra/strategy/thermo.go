@@ -0,0 +1,46 @@
+package strategy
+
+import (
+	"github.com/lumaraf/sudoku-solver/extra/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+func ThermoStrategyFactory[D sudoku.Digits[D], A sudoku.Area[A]](s sudoku.Sudoku[D, A]) []sudoku.Strategy[D, A] {
+	// collect the cells that are known to be larger than each thermometer cell, including cells on other thermometers
+	// that share a cell with it
+	larger := map[sudoku.CellLocation]A{}
+	area := s.NewArea()
+	for r := range sudoku.GetRestrictions[D, A, rule.ThermoRestriction[D, A]](s) {
+		path := r.Path()
+		for n, l := range path {
+			larger[l] = larger[l].Or(s.NewArea(path[n+1:]...))
+		}
+		area = area.Or(r.Area())
+	}
+	if area.Empty() {
+		return nil
+	}
+	return []sudoku.Strategy[D, A]{ThermoStrategy[D, A]{newOrderStrategy(s, area, larger)}}
+}
+
+// ThermoStrategy limits the digits of thermometer cells by the number of distinct digits that have to fit above and
+// below them. This also covers branching thermometers, where cells on different branches see each other.
+type ThermoStrategy[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	orderStrategy[D, A]
+}
+
+func (st ThermoStrategy[D, A]) Name() string {
+	return "ThermoStrategy"
+}
+
+func (st ThermoStrategy[D, A]) Difficulty() sudoku.Difficulty {
+	return sudoku.DIFFICULTY_NORMAL
+}
+
+func (st ThermoStrategy[D, A]) Solve(s sudoku.Sudoku[D, A], push func(sudoku.Strategy[D, A])) error {
+	if err := st.limit(s); err != nil {
+		return err
+	}
+	push(st)
+	return nil
+}
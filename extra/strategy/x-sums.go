@@ -0,0 +1,71 @@
+package strategy
+
+import (
+	"errors"
+
+	"github.com/lumaraf/sudoku-solver/extra/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+func XSumStrategyFactory[D sudoku.Digits[D], A sudoku.Area[A]](s sudoku.Sudoku[D, A]) []sudoku.Strategy[D, A] {
+	strategies := sudoku.Strategies[D, A]{}
+	for r := range sudoku.GetRestrictions[D, A, rule.XSumRestriction[D, A]](s) {
+		if !s.IsUniqueArea(r.Area()) {
+			continue
+		}
+		strategies = append(strategies, XSumStrategy[D, A]{
+			restriction: r,
+		})
+	}
+	return strategies
+}
+
+// XSumStrategy enumerates the digits next to an x-sum clue, up to the digit that gives their count.
+type XSumStrategy[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	restriction rule.XSumRestriction[D, A]
+}
+
+func (st XSumStrategy[D, A]) Name() string {
+	return "XSumStrategy"
+}
+
+func (st XSumStrategy[D, A]) Difficulty() sudoku.Difficulty {
+	return sudoku.DIFFICULTY_NORMAL
+}
+
+func (st XSumStrategy[D, A]) AreaFilter() A {
+	return st.restriction.Area()
+}
+
+func (st XSumStrategy[D, A]) Solve(s sudoku.Sudoku[D, A], push func(sudoku.Strategy[D, A])) error {
+	if st.restriction.Area().And(s.SolvedArea().Not()).Empty() {
+		return nil
+	}
+
+	clue := st.restriction.Clue()
+	cells := st.restriction.Cells()
+	possible := enumerateLine(s, cells, func(values []int) (bool, bool) {
+		sum := 0
+		for _, v := range values {
+			sum += v
+		}
+		count := values[0]
+		if len(values) == count {
+			return sum == clue, true
+		}
+		// every remaining digit adds at least 1
+		return sum+count-len(values) <= clue, false
+	})
+
+	for n, l := range cells {
+		if possible[n].Empty() {
+			return errors.New("no valid placement for x-sum")
+		}
+		if err := s.Mask(l, possible[n]); err != nil {
+			return err
+		}
+	}
+
+	push(st)
+	return nil
+}
@@ -0,0 +1,17 @@
+package rule
+
+import "github.com/lumaraf/sudoku-solver/sudoku"
+
+// AlphabetRule changes the symbols of the digits, e.g. to 0-8, 0-F for hexadoku or letters for wordoku. It has to be
+// used before all other rules.
+type AlphabetRule[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	Alphabet sudoku.Alphabet
+}
+
+func (r AlphabetRule[D, A]) Name() string {
+	return "alphabet"
+}
+
+func (r AlphabetRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	return sb.SetAlphabet(r.Alphabet)
+}
@@ -0,0 +1,69 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+var (
+	ErrInvalidRegions = errors.New("regions don't partition the grid")
+)
+
+// JigsawRules are the classic rules with irregular regions replacing the boxes.
+type JigsawRules[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	Regions [][]sudoku.CellLocation
+}
+
+// JigsawRulesFromString creates jigsaw rules from a grid of region labels. Every cell has to be labeled, cells with the
+// same label belong to the same region.
+func JigsawRulesFromString[D sudoku.Digits[D], A sudoku.Area[A]](rows ...string) JigsawRules[D, A] {
+	labels := make(map[rune]int)
+	regions := make([][]sudoku.CellLocation, 0)
+	for row, rowContent := range rows {
+		for col, cellContent := range []rune(rowContent) {
+			if cellContent == ' ' {
+				continue
+			}
+			idx, ok := labels[cellContent]
+			if !ok {
+				idx = len(regions)
+				labels[cellContent] = idx
+				regions = append(regions, nil)
+			}
+			regions[idx] = append(regions[idx], sudoku.CellLocation{Row: row, Col: col})
+		}
+	}
+	return JigsawRules[D, A]{Regions: regions}
+}
+
+func (r JigsawRules[D, A]) Name() string {
+	return "jigsaw"
+}
+
+func (r JigsawRules[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	if len(r.Regions) != sb.Size() {
+		return fmt.Errorf("%w: expected %d regions, got %d", ErrInvalidRegions, sb.Size(), len(r.Regions))
+	}
+
+	rules := lineRules(sb)
+	covered := sb.NewArea()
+	for n, region := range r.Regions {
+		for _, l := range region {
+			if l.Row < 0 || l.Row >= sb.Size() || l.Col < 0 || l.Col >= sb.Size() {
+				return fmt.Errorf("%w: cell %d,%d of region %d is outside of the grid", ErrInvalidRegions, l.Row, l.Col, n+1)
+			}
+		}
+		a := sb.NewArea(region...)
+		if a.Count() != sb.Size() {
+			return fmt.Errorf("%w: region %d contains %d cells instead of %d", ErrInvalidRegions, n+1, a.Count(), sb.Size())
+		}
+		if !covered.And(a).Empty() {
+			return fmt.Errorf("%w: region %d overlaps another region", ErrInvalidRegions, n+1)
+		}
+		covered = covered.Or(a)
+		rules = append(rules, NewUniqueAreaRule[D, A](fmt.Sprintf("region %d", n+1), a))
+	}
+	return sb.Use(rules...)
+}
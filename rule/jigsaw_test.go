@@ -0,0 +1,95 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJigsawRules(t *testing.T) {
+	for _, test := range []struct {
+		name          string
+		rows          []string
+		expectedError error
+	}{
+		{
+			name: "valid",
+			rows: []string{
+				"AAAABBBBB",
+				"AAACCCBBB",
+				"ADDDCCCBE",
+				"ADDDCCFEE",
+				"GDDDCFFFE",
+				"GGGHHFFEE",
+				"GGHHHFFEE",
+				"GGHHIFIIE",
+				"GHHIIIIII",
+			},
+		},
+		{
+			name: "too few regions",
+			rows: []string{
+				"AAAABBBBB",
+				"AAACCCBBB",
+				"ADDDCCCBE",
+				"ADDDCCFEE",
+				"GDDDCFFFE",
+				"GGGHHFFEE",
+				"GGHHHFFEE",
+				"GGHHHFHHE",
+				"GHHHHHHHH",
+			},
+			expectedError: ErrInvalidRegions,
+		},
+		{
+			name: "uneven regions",
+			rows: []string{
+				"AAAABBBBB",
+				"AAACCCBBB",
+				"ADDDCCCBE",
+				"ADDDCCFEE",
+				"GDDDCFFFE",
+				"GGGHHFFEE",
+				"GGHHHFFEE",
+				"GGHHIFIIE",
+				"GGHIIIIII",
+			},
+			expectedError: ErrInvalidRegions,
+		},
+		{
+			name: "missing cells",
+			rows: []string{
+				"AAAABBBBB",
+				"AAACCCBBB",
+				"ADDDCCCBE",
+				"ADDDCCFEE",
+				"GDDDCFFFE",
+				"GGGHHFFEE",
+				"GGHHHFFEE",
+				"GGHHIFIIE",
+				"GHHIIIII ",
+			},
+			expectedError: ErrInvalidRegions,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := sudoku.NewSudoku9x9(
+				JigsawRulesFromString[sudoku.Digits9, sudoku.Area9x9](test.rows...),
+			)
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+
+			regions := 0
+			for r := range sudoku.GetRestrictions[sudoku.Digits9, sudoku.Area9x9, UniqueRestriction[sudoku.Digits9, sudoku.Area9x9]](s) {
+				assert.Equal(t, 9, r.Area().Count())
+				regions++
+			}
+			assert.Equal(t, 27, regions)
+			assert.Equal(t, 18, s.GetExclusionArea(sudoku.CellLocation{Row: 0, Col: 0}).Count())
+		})
+	}
+}
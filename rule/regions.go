@@ -0,0 +1,151 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+var (
+	ErrUnsupportedSize = errors.New("unsupported grid size")
+)
+
+// cellsFromNotation converts 1-based row and column pairs to cell locations.
+func cellsFromNotation(cells ...[2]int) []sudoku.CellLocation {
+	locations := make([]sudoku.CellLocation, 0, len(cells))
+	for _, c := range cells {
+		locations = append(locations, sudoku.CellLocation{Row: c[0] - 1, Col: c[1] - 1})
+	}
+	return locations
+}
+
+// WindokuRule adds windows between the boxes, which have the size of a box and contain unique digits. A 9x9 grid has
+// four windows.
+type WindokuRule[D sudoku.Digits[D], A sudoku.Area[A]] struct{}
+
+func (r WindokuRule[D, A]) Name() string {
+	return "windoku"
+}
+
+func (r WindokuRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	boxRows, boxCols := sb.BoxSize()
+	if boxRows != boxCols {
+		return fmt.Errorf("%w: windoku needs square boxes", ErrUnsupportedSize)
+	}
+
+	n := boxRows
+	rules := make(sudoku.Rules[D, A], 0, (n-1)*(n-1))
+	for windowRow := 0; windowRow < n-1; windowRow++ {
+		for windowCol := 0; windowCol < n-1; windowCol++ {
+			window := sb.NewArea()
+			for row := 0; row < n; row++ {
+				for col := 0; col < n; col++ {
+					window = window.With(sudoku.CellLocation{
+						Row: 1 + windowRow*(n+1) + row,
+						Col: 1 + windowCol*(n+1) + col,
+					})
+				}
+			}
+			rules = append(rules, NewUniqueAreaRule[D, A](fmt.Sprintf("window %d", len(rules)+1), window))
+		}
+	}
+	return sb.Use(rules...)
+}
+
+// CentreDotRule requires the centre cells of all boxes to contain unique digits.
+type CentreDotRule[D sudoku.Digits[D], A sudoku.Area[A]] struct{}
+
+func (r CentreDotRule[D, A]) Name() string {
+	return "centre dot"
+}
+
+func (r CentreDotRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	boxRows, boxCols := sb.BoxSize()
+	if boxRows%2 == 0 || boxCols%2 == 0 {
+		return fmt.Errorf("%w: centre dot needs boxes with a centre cell", ErrUnsupportedSize)
+	}
+
+	centres := sb.NewArea()
+	for row := boxRows / 2; row < sb.Size(); row += boxRows {
+		for col := boxCols / 2; col < sb.Size(); col += boxCols {
+			centres = centres.With(sudoku.CellLocation{Row: row, Col: col})
+		}
+	}
+	return sb.Use(NewUniqueAreaRule[D, A]("centre dot", centres))
+}
+
+// AsteriskRule adds a region of nine cells in the shape of an asterisk. It only works with 9x9 sudoku.
+type AsteriskRule[D sudoku.Digits[D], A sudoku.Area[A]] struct{}
+
+func (r AsteriskRule[D, A]) Name() string {
+	return "asterisk"
+}
+
+func (r AsteriskRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	if sb.Size() != 9 {
+		return fmt.Errorf("%w: asterisk only works with 9x9 sudoku", ErrUnsupportedSize)
+	}
+
+	return sb.Use(NewUniqueAreaRule[D, A]("asterisk", sb.NewArea(cellsFromNotation(
+		[2]int{2, 5},
+		[2]int{3, 3}, [2]int{3, 7},
+		[2]int{5, 2}, [2]int{5, 5}, [2]int{5, 8},
+		[2]int{7, 3}, [2]int{7, 7},
+		[2]int{8, 5},
+	)...)))
+}
+
+// GirandolaRule adds a region of nine cells in the corners, the centre and around the centre. It only works with 9x9
+// sudoku.
+type GirandolaRule[D sudoku.Digits[D], A sudoku.Area[A]] struct{}
+
+func (r GirandolaRule[D, A]) Name() string {
+	return "girandola"
+}
+
+func (r GirandolaRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	if sb.Size() != 9 {
+		return fmt.Errorf("%w: girandola only works with 9x9 sudoku", ErrUnsupportedSize)
+	}
+
+	return sb.Use(NewUniqueAreaRule[D, A]("girandola", sb.NewArea(cellsFromNotation(
+		[2]int{1, 1}, [2]int{1, 9},
+		[2]int{2, 5},
+		[2]int{5, 2}, [2]int{5, 5}, [2]int{5, 8},
+		[2]int{8, 5},
+		[2]int{9, 1}, [2]int{9, 9},
+	)...)))
+}
+
+// ArgyleRule adds eight diagonals in an argyle pattern, which contain unique digits. Four diagonals have a length of 8
+// and four have a length of 5. It only works with 9x9 sudoku.
+type ArgyleRule[D sudoku.Digits[D], A sudoku.Area[A]] struct{}
+
+func (r ArgyleRule[D, A]) Name() string {
+	return "argyle"
+}
+
+func (r ArgyleRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	if sb.Size() != 9 {
+		return fmt.Errorf("%w: argyle only works with 9x9 sudoku", ErrUnsupportedSize)
+	}
+
+	diagonal := func(start sudoku.CellLocation, colStep, length int) A {
+		a := sb.NewArea()
+		for n := 0; n < length; n++ {
+			a = a.With(sudoku.CellLocation{Row: start.Row + n, Col: start.Col + n*colStep})
+		}
+		return a
+	}
+	return sb.Use(
+		NewUniqueAreaRule[D, A]("argyle diagonal 1", diagonal(sudoku.CellLocation{Row: 0, Col: 1}, 1, 8)),
+		NewUniqueAreaRule[D, A]("argyle diagonal 2", diagonal(sudoku.CellLocation{Row: 1, Col: 0}, 1, 8)),
+		NewUniqueAreaRule[D, A]("argyle diagonal 3", diagonal(sudoku.CellLocation{Row: 0, Col: 7}, -1, 8)),
+		NewUniqueAreaRule[D, A]("argyle diagonal 4", diagonal(sudoku.CellLocation{Row: 1, Col: 8}, -1, 8)),
+		NewUniqueAreaRule[D, A]("argyle diagonal 5", diagonal(sudoku.CellLocation{Row: 0, Col: 4}, 1, 5)),
+		NewUniqueAreaRule[D, A]("argyle diagonal 6", diagonal(sudoku.CellLocation{Row: 4, Col: 0}, 1, 5)),
+		NewUniqueAreaRule[D, A]("argyle diagonal 7", diagonal(sudoku.CellLocation{Row: 0, Col: 4}, -1, 5)),
+		NewUniqueAreaRule[D, A]("argyle diagonal 8", diagonal(sudoku.CellLocation{Row: 4, Col: 8}, -1, 5)),
+	)
+}
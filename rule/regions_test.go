@@ -0,0 +1,33 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/lumaraf/sudoku-solver/sudoku"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWindokuRule(t *testing.T) {
+	s, err := sudoku.NewSudoku9x9(
+		ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+		WindokuRule[sudoku.Digits9, sudoku.Area9x9]{},
+	)
+	assert.NoError(t, err)
+
+	// the corner of a window sees 3 more cells, the corner of the grid isn't part of a window
+	assert.Equal(t, 23, s.GetExclusionArea(sudoku.CellLocation{Row: 2, Col: 2}).Count())
+	assert.Equal(t, 20, s.GetExclusionArea(sudoku.CellLocation{Row: 0, Col: 0}).Count())
+}
+
+func TestExtraRegionRules_UnsupportedSize(t *testing.T) {
+	for name, r := range map[string]sudoku.Rule[sudoku.Digits6, sudoku.Area6x6]{
+		"windoku":    WindokuRule[sudoku.Digits6, sudoku.Area6x6]{},
+		"centre dot": CentreDotRule[sudoku.Digits6, sudoku.Area6x6]{},
+		"asterisk":   AsteriskRule[sudoku.Digits6, sudoku.Area6x6]{},
+		"girandola":  GirandolaRule[sudoku.Digits6, sudoku.Area6x6]{},
+		"argyle":     ArgyleRule[sudoku.Digits6, sudoku.Area6x6]{},
+	} {
+		_, err := sudoku.NewSudoku6x6(r)
+		assert.ErrorIs(t, err, ErrUnsupportedSize, name)
+	}
+}
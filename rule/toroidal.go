@@ -0,0 +1,16 @@
+package rule
+
+import "github.com/lumaraf/sudoku-solver/sudoku"
+
+// ToroidalRule makes the grid wrap around at its edges, so offsets and diagonals continue on the opposite side, e.g. for
+// anti-king or anti-knight rules. The order of the rules doesn't matter.
+type ToroidalRule[D sudoku.Digits[D], A sudoku.Area[A]] struct{}
+
+func (r ToroidalRule[D, A]) Name() string {
+	return "toroidal"
+}
+
+func (r ToroidalRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	sb.SetToroidal(true)
+	return nil
+}
@@ -50,6 +50,28 @@ func (r UniqueAreaRule[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
 type ClassicRules[D sudoku.Digits[D], A sudoku.Area[A]] struct{}
 
 func (r ClassicRules[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	rules := lineRules(sb)
+	for box := 0; box < sb.Size(); box++ {
+		a := sb.Box(box)
+		rules = append(rules, NewUniqueAreaRule[D, A](fmt.Sprintf("box %d", box+1), a))
+	}
+	return sb.Use(rules...)
+}
+
+// LatinSquareRules require each digit to appear exactly once in each row and column. Unlike the classic rules, there
+// are no boxes.
+type LatinSquareRules[D sudoku.Digits[D], A sudoku.Area[A]] struct{}
+
+func (r LatinSquareRules[D, A]) Name() string {
+	return "latin square"
+}
+
+func (r LatinSquareRules[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
+	return sb.Use(lineRules(sb)...)
+}
+
+// lineRules creates the unique area rules for all rows and columns
+func lineRules[D sudoku.Digits[D], A sudoku.Area[A]](sb sudoku.SudokuBuilder[D, A]) sudoku.Rules[D, A] {
 	rules := make(sudoku.Rules[D, A], 0, sb.Size()*3)
 	for row := 0; row < sb.Size(); row++ {
 		a := sb.Row(row)
@@ -59,11 +81,7 @@ func (r ClassicRules[D, A]) Apply(sb sudoku.SudokuBuilder[D, A]) error {
 		a := sb.Column(col)
 		rules = append(rules, NewUniqueAreaRule[D, A](fmt.Sprintf("col %d", col+1), a))
 	}
-	for box := 0; box < sb.Size(); box++ {
-		a := sb.Box(box)
-		rules = append(rules, NewUniqueAreaRule[D, A](fmt.Sprintf("box %d", box+1), a))
-	}
-	return sb.Use(rules...)
+	return rules
 }
 
 type DiagonalRule[D sudoku.Digits[D], A sudoku.Area[A]] struct{}
@@ -21,6 +21,20 @@ func TestClassicRules(t *testing.T) {
 	}
 }
 
+func TestLatinSquareRules(t *testing.T) {
+	s, err := sudoku.NewSudoku9x9(
+		LatinSquareRules[sudoku.Digits9, sudoku.Area9x9]{},
+	)
+	assert.NoError(t, err)
+
+	for row := 0; row < 9; row++ {
+		for col := 0; col < 9; col++ {
+			l := sudoku.CellLocation{row, col}
+			assert.Equal(t, 16, s.GetExclusionArea(l).Count())
+		}
+	}
+}
+
 func TestUniqueRestriction_Validate(t *testing.T) {
 	for _, test := range []struct {
 		name          string
@@ -23,10 +23,17 @@ func PatternOverlayStrategyFactory[D sudoku.Digits[D], A sudoku.Area[A]](s sudok
 		break
 	}
 
+	var pairMaskRestriction *sudoku.PairMaskRestriction[D, A]
+	for r := range sudoku.GetRestrictions[D, A, sudoku.PairMaskRestriction[D, A]](s) {
+		pairMaskRestriction = &r
+		break
+	}
+
 	return []sudoku.Strategy[D, A]{PatternOverlayStrategy[D, A]{
 		area:                  s.NewArea().Not(),
 		requiredAreas:         requiredAreas,
 		offsetMaskRestriction: offsetMaskRestriction,
+		pairMaskRestriction:   pairMaskRestriction,
 	}}
 }
 
@@ -35,6 +42,7 @@ type PatternOverlayStrategy[D sudoku.Digits[D], A sudoku.Area[A]] struct {
 	requiredAreas         []A
 	extraAreas            []A
 	offsetMaskRestriction *sudoku.OffsetMaskRestriction[D, A]
+	pairMaskRestriction   *sudoku.PairMaskRestriction[D, A]
 }
 
 func (st PatternOverlayStrategy[D, A]) Name() string {
@@ -114,7 +122,16 @@ func (st PatternOverlayStrategy[D, A]) Solve(s sudoku.Sudoku[D, A], push func(su
 				if offsetMasks != nil {
 					for offset, mask := range offsetMasks {
 						if !mask.CanContain(otherValue + v + 2) {
-							area = area.Or(p.area.ShiftBy(offset))
+							area = area.Or(s.ShiftArea(p.area, offset))
+						}
+					}
+				}
+				if st.pairMaskRestriction != nil {
+					for _, l := range p.area.Locations {
+						for other, mask := range st.pairMaskRestriction.MasksForCell(l, v+1) {
+							if !mask.CanContain(otherValue + v + 2) {
+								area = area.With(other)
+							}
 						}
 					}
 				}
@@ -9,6 +9,12 @@ func SetEquivalenceStrategyFactory[D sudoku.Digits[D], A sudoku.Area[A]](s sudok
 	if s.Size() != 9 {
 		return nil
 	}
+	// the boxes need to contain unique digits, which isn't the case for latin squares or jigsaw regions
+	for box := 0; box < s.Size(); box++ {
+		if !s.IsUniqueArea(s.Box(box)) {
+			return nil
+		}
+	}
 
 	strategies := make([]sudoku.Strategy[D, A], 0)
 	for row1 := 0; row1 < s.Size()-1; row1++ {
@@ -0,0 +1,104 @@
+package strategy
+
+import (
+	"fmt"
+
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+// places digits that fit into only one cell of a unique area that has to contain every digit
+func HiddenSingleStrategyFactory[D sudoku.Digits[D], A sudoku.Area[A]](s sudoku.Sudoku[D, A]) []sudoku.Strategy[D, A] {
+	strategies := make([]sudoku.Strategy[D, A], 0)
+	for r := range sudoku.GetRestrictions[D, A, rule.UniqueRestriction[D, A]](s) {
+		a := r.Area()
+		if a.Count() < s.Size() {
+			continue
+		}
+
+		strategies = append(strategies, HiddenSingleStrategy[D, A]{
+			area: a,
+		})
+	}
+	return strategies
+}
+
+type HiddenSingleStrategy[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	area A
+}
+
+func (st HiddenSingleStrategy[D, A]) Name() string {
+	return "HiddenSingleStrategy"
+}
+
+func (st HiddenSingleStrategy[D, A]) Difficulty() sudoku.Difficulty {
+	return sudoku.DIFFICULTY_EASY
+}
+
+func (st HiddenSingleStrategy[D, A]) AreaFilter() A {
+	return st.area
+}
+
+func (st HiddenSingleStrategy[D, A]) Solve(s sudoku.Sudoku[D, A], push func(sudoku.Strategy[D, A])) error {
+	if st.area.And(s.SolvedArea().Not()).Empty() {
+		return nil
+	}
+
+	for v := 1; v <= s.Size(); v++ {
+		locations := s.PossibleLocations(v).And(st.area)
+		switch locations.Count() {
+		case 0:
+			return fmt.Errorf("digit %c doesn't fit into the area", s.Alphabet().Symbol(v))
+		case 1:
+			for _, l := range locations.Locations {
+				if err := s.Set(l, v); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	push(st)
+	return nil
+}
+
+// removes the digits of cells that were solved by earlier strategies of the same round from all cells that see them,
+// before the solver does the same while processing the changes
+func NakedSingleStrategyFactory[D sudoku.Digits[D], A sudoku.Area[A]](s sudoku.Sudoku[D, A]) []sudoku.Strategy[D, A] {
+	return []sudoku.Strategy[D, A]{NakedSingleStrategy[D, A]{
+		area: s.NewArea().Not(),
+	}}
+}
+
+type NakedSingleStrategy[D sudoku.Digits[D], A sudoku.Area[A]] struct {
+	area A
+}
+
+func (st NakedSingleStrategy[D, A]) Name() string {
+	return "NakedSingleStrategy"
+}
+
+func (st NakedSingleStrategy[D, A]) Difficulty() sudoku.Difficulty {
+	return sudoku.DIFFICULTY_EASY
+}
+
+func (st NakedSingleStrategy[D, A]) AreaFilter() A {
+	return st.area
+}
+
+func (st NakedSingleStrategy[D, A]) Solve(s sudoku.Sudoku[D, A], push func(sudoku.Strategy[D, A])) error {
+	// cells solved in earlier rounds have already been processed
+	for _, l := range s.NextChangedArea().And(s.SolvedArea()).Locations {
+		d := s.Get(l)
+		v, _ := d.Single()
+		others := s.PossibleLocations(v).Without(l).And(s.GetExclusionArea(l))
+		for _, other := range others.Locations {
+			if err := s.RemoveMask(other, d); err != nil {
+				return err
+			}
+		}
+	}
+
+	push(st)
+	return nil
+}
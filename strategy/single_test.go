@@ -0,0 +1,134 @@
+package strategy
+
+import (
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+	"testing"
+)
+
+func TestHiddenSingleStrategy_Solve(t *testing.T) {
+	s, err := sudoku.NewSudoku9x9()
+	if err != nil {
+		t.Fatalf("failed to create sudoku: %v", err)
+	}
+	for n := 1; n < 9; n++ {
+		s.RemoveOption(sudoku.CellLocation{Row: 0, Col: n}, 5)
+	}
+	strategy := HiddenSingleStrategy[sudoku.Digits9, sudoku.Area9x9]{
+		area: s.Row(0),
+	}
+	err = strategy.Solve(s, func(s sudoku.Strategy[sudoku.Digits9, sudoku.Area9x9]) {})
+	if err != nil {
+		t.Errorf("HiddenSingleStrategy.Solve failed: %v", err)
+	}
+	if v, ok := s.Get(sudoku.CellLocation{Row: 0, Col: 0}).Single(); !ok || v != 5 {
+		t.Errorf("expected 5 in cell 0,0, got %v", s.Get(sudoku.CellLocation{Row: 0, Col: 0}))
+	}
+
+	for n := 0; n < 9; n++ {
+		s.RemoveOption(sudoku.CellLocation{Row: 1, Col: n}, 7)
+	}
+	strategy = HiddenSingleStrategy[sudoku.Digits9, sudoku.Area9x9]{
+		area: s.Row(1),
+	}
+	err = strategy.Solve(s, func(s sudoku.Strategy[sudoku.Digits9, sudoku.Area9x9]) {})
+	if err == nil {
+		t.Errorf("HiddenSingleStrategy.Solve should fail without a cell for 7")
+	}
+}
+
+// contextLogger counts the cell updates made inside each context.
+type contextLogger struct {
+	context []string
+	updates map[string]int
+}
+
+func (l *contextLogger) UpdateCell(loc sudoku.CellLocation, old, new sudoku.Digits9) {
+	for _, name := range l.context {
+		l.updates[name]++
+	}
+}
+
+func (l *contextLogger) EnterContext(n sudoku.NamedContext) {
+	l.context = append(l.context, n.Name())
+}
+
+func (l *contextLogger) ExitContext() {
+	l.context = l.context[:len(l.context)-1]
+}
+
+func TestSingleStrategies(t *testing.T) {
+	s, err := sudoku.NewSudoku9x9(
+		rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+		rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+			" 3       ",
+			"   195   ",
+			"  8    6 ",
+			"8   6    ",
+			"4  8    1",
+			"    2    ",
+			" 6    28 ",
+			"   419  5",
+			"       7 ",
+		),
+	)
+	if err != nil {
+		t.Fatalf("failed to create sudoku: %v", err)
+	}
+	logger := &contextLogger{updates: map[string]int{}}
+	s.SetLogger(logger)
+
+	// an easy puzzle only needs singles
+	slv := s.NewSolver()
+	slv.Use(
+		sudoku.StrategyFactoryFunc[sudoku.Digits9, sudoku.Area9x9](HiddenSingleStrategyFactory[sudoku.Digits9, sudoku.Area9x9]),
+		sudoku.StrategyFactoryFunc[sudoku.Digits9, sudoku.Area9x9](NakedSingleStrategyFactory[sudoku.Digits9, sudoku.Area9x9]),
+	)
+	slv.SetChainLimit(0)
+	if err := slv.Solve(t.Context()); err != nil {
+		t.Errorf("solving with singles failed: %v", err)
+	}
+	if !s.IsSolved() {
+		s.Print()
+		t.Errorf("expected singles to solve the puzzle")
+	}
+
+	// the digits placed by hidden singles are removed from the other cells by the naked single strategy
+	if logger.updates["HiddenSingleStrategy"] == 0 {
+		t.Errorf("expected hidden singles to place digits")
+	}
+	if logger.updates["NakedSingleStrategy"] == 0 {
+		t.Errorf("expected naked singles to remove candidates")
+	}
+}
+
+func TestSingleStrategies_SolversInTurn(t *testing.T) {
+	s, err := sudoku.NewSudoku9x9(rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{})
+	if err != nil {
+		t.Fatalf("failed to create sudoku: %v", err)
+	}
+
+	slv := s.NewSolver()
+	slv.Use(sudoku.StrategyFactoryFunc[sudoku.Digits9, sudoku.Area9x9](NakedSingleStrategyFactory[sudoku.Digits9, sudoku.Area9x9]))
+	slv.SetChainLimit(0)
+	if err := slv.Solve(t.Context()); err != nil {
+		t.Fatalf("solving the empty grid failed: %v", err)
+	}
+
+	// a previous solver must not keep the solved digits from being excluded
+	if err := s.Set(sudoku.CellLocation{Row: 0, Col: 0}, 1); err != nil {
+		t.Fatalf("failed to set cell: %v", err)
+	}
+	if err := s.ProcessChanges(); err != nil {
+		t.Fatalf("failed to process changes: %v", err)
+	}
+	slv = s.NewSolver()
+	slv.Use(sudoku.StrategyFactoryFunc[sudoku.Digits9, sudoku.Area9x9](HiddenSingleStrategyFactory[sudoku.Digits9, sudoku.Area9x9]))
+	slv.SetChainLimit(0)
+	if err := slv.Solve(t.Context()); err != nil {
+		t.Errorf("solving failed: %v", err)
+	}
+	if s.Get(sudoku.CellLocation{Row: 0, Col: 1}).CanContain(1) {
+		t.Errorf("expected 1 to be removed from cell 0,1")
+	}
+}
@@ -5,6 +5,16 @@ import "github.com/lumaraf/sudoku-solver/sudoku"
 // AllStrategies returns all available strategies used by the solver.
 func AllStrategies[D sudoku.Digits[D], A sudoku.Area[A]]() sudoku.StrategyFactories[D, A] {
 	return sudoku.StrategyFactories[D, A]{
+		// HiddenSingleStrategy:
+		// Places a digit that can only go into one cell of a unit (row, column or box) that has to contain every digit.
+		sudoku.StrategyFactoryFunc[D, A](HiddenSingleStrategyFactory[D, A]),
+
+		// NakedSingleStrategy:
+		// Removes the digit of a cell with a single candidate from all cells that see it, e.g. in the same row, column or box.
+		// It handles the cells solved by the strategies before it in the same round, so the step is attributed to it instead of
+		// happening while the solver processes the changes.
+		sudoku.StrategyFactoryFunc[D, A](NakedSingleStrategyFactory[D, A]),
+
 		// UniqueSetStrategy:
 		// Detects sets of cells within a unit (row, column or box) that contain exactly N candidates among N cells.
 		// Removes these candidates from all other cells in the same unit. This is commonly known as the "naked set" technique.
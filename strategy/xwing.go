@@ -64,7 +64,7 @@ func (slv XWingStrategy[D, A]) Solve(s sudoku.Sudoku[D, A], push func(sudoku.Str
 		return nil
 	}
 
-	for digit := 1; digit <= 9; digit++ {
+	for digit := 1; digit <= s.Size(); digit++ {
 		slv.findXWing(s, digit)
 	}
 	push(slv)
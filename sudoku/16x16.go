@@ -0,0 +1,46 @@
+package sudoku
+
+func NewSudoku16x16(rules ...Rule[Digits16, Area16x16]) (Sudoku[Digits16, Area16x16], error) {
+	builder := NewSudokuBuilder16x16()
+	if err := builder.Use(rules...); err != nil {
+		return nil, err
+	}
+	return builder.Build()
+}
+
+func NewSudokuBuilder16x16() SudokuBuilder[Digits16, Area16x16] {
+	return newSudokuBuilder[Digits16, Area16x16, grid16x16, size16, genericGridOps[Digits16, Area16x16, grid16x16, size16]]()
+}
+
+type Area16x16 = area256[size16]
+
+type Digits16 = digits_16[size16]
+
+type grid16x16 [16 * 16]Digits16
+
+type size16 struct{}
+
+func (size16) allCells() [4]uint64 {
+	return [4]uint64{
+		0xFFFFFFFFFFFFFFFF,
+		0xFFFFFFFFFFFFFFFF,
+		0xFFFFFFFFFFFFFFFF,
+		0xFFFFFFFFFFFFFFFF,
+	}
+}
+
+func (size16) allDigits() uint16 {
+	return 0xFFFF
+}
+
+func (s size16) Size() int {
+	return 16
+}
+
+func (s size16) BoxSize() (int, int) {
+	return 4, 4
+}
+
+func (s size16) GridCell(g *grid16x16, row, col int) *Digits16 {
+	return &g[row*16+col]
+}
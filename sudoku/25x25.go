@@ -0,0 +1,52 @@
+package sudoku
+
+func NewSudoku25x25(rules ...Rule[Digits25, Area25x25]) (Sudoku[Digits25, Area25x25], error) {
+	builder := NewSudokuBuilder25x25()
+	if err := builder.Use(rules...); err != nil {
+		return nil, err
+	}
+	return builder.Build()
+}
+
+func NewSudokuBuilder25x25() SudokuBuilder[Digits25, Area25x25] {
+	return newSudokuBuilder[Digits25, Area25x25, grid25x25, size25, genericGridOps[Digits25, Area25x25, grid25x25, size25]]()
+}
+
+type Area25x25 = area640[size25]
+
+type Digits25 = digits_32[size25]
+
+type grid25x25 [25 * 25]Digits25
+
+type size25 struct{}
+
+func (size25) allCells() [10]uint64 {
+	return [10]uint64{
+		0xFFFFFFFFFFFFFFFF,
+		0xFFFFFFFFFFFFFFFF,
+		0xFFFFFFFFFFFFFFFF,
+		0xFFFFFFFFFFFFFFFF,
+		0xFFFFFFFFFFFFFFFF,
+		0xFFFFFFFFFFFFFFFF,
+		0xFFFFFFFFFFFFFFFF,
+		0xFFFFFFFFFFFFFFFF,
+		0xFFFFFFFFFFFFFFFF,
+		0x1FFFFFFFFFFFF,
+	}
+}
+
+func (size25) allDigits() uint32 {
+	return 0x1FFFFFF
+}
+
+func (s size25) Size() int {
+	return 25
+}
+
+func (s size25) BoxSize() (int, int) {
+	return 5, 5
+}
+
+func (s size25) GridCell(g *grid25x25, row, col int) *Digits25 {
+	return &g[row*25+col]
+}
@@ -0,0 +1,41 @@
+package sudoku
+
+func NewSudoku4x4(rules ...Rule[Digits4, Area4x4]) (Sudoku[Digits4, Area4x4], error) {
+	builder := NewSudokuBuilder4x4()
+	if err := builder.Use(rules...); err != nil {
+		return nil, err
+	}
+	return builder.Build()
+}
+
+func NewSudokuBuilder4x4() SudokuBuilder[Digits4, Area4x4] {
+	return newSudokuBuilder[Digits4, Area4x4, grid4x4, size4, genericGridOps[Digits4, Area4x4, grid4x4, size4]]()
+}
+
+type Area4x4 = area128[size4]
+
+type Digits4 = digits_16[size4]
+
+type grid4x4 [4 * 4]Digits4
+
+type size4 struct{}
+
+func (size4) allCells() [2]uint64 {
+	return [2]uint64{0xFFFF, 0}
+}
+
+func (size4) allDigits() uint16 {
+	return 0b1111
+}
+
+func (s size4) Size() int {
+	return 4
+}
+
+func (s size4) BoxSize() (int, int) {
+	return 2, 2
+}
+
+func (s size4) GridCell(g *grid4x4, row, col int) *Digits4 {
+	return &g[row*4+col]
+}
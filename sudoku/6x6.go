@@ -50,7 +50,7 @@ func (s size6) NewArea(locs ...CellLocation) Area6x6 {
 
 func (s size6) NewAreaFromOffsets(center CellLocation, o Offsets) Area6x6 {
 	a := Area6x6{}
-	for loc := range o.locations(s.Size(), center) {
+	for loc := range o.locations(s.Size(), center, false) {
 		a = a.With(loc)
 	}
 	return a
@@ -0,0 +1,41 @@
+package sudoku
+
+func NewSudoku8x8(rules ...Rule[Digits8, Area8x8]) (Sudoku[Digits8, Area8x8], error) {
+	builder := NewSudokuBuilder8x8()
+	if err := builder.Use(rules...); err != nil {
+		return nil, err
+	}
+	return builder.Build()
+}
+
+func NewSudokuBuilder8x8() SudokuBuilder[Digits8, Area8x8] {
+	return newSudokuBuilder[Digits8, Area8x8, grid8x8, size8, genericGridOps[Digits8, Area8x8, grid8x8, size8]]()
+}
+
+type Area8x8 = area128[size8]
+
+type Digits8 = digits_16[size8]
+
+type grid8x8 [8 * 8]Digits8
+
+type size8 struct{}
+
+func (size8) allCells() [2]uint64 {
+	return [2]uint64{0xFFFFFFFFFFFFFFFF, 0}
+}
+
+func (size8) allDigits() uint16 {
+	return 0b11111111
+}
+
+func (s size8) Size() int {
+	return 8
+}
+
+func (s size8) BoxSize() (int, int) {
+	return 2, 4
+}
+
+func (s size8) GridCell(g *grid8x8, row, col int) *Digits8 {
+	return &g[row*8+col]
+}
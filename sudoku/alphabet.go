@@ -0,0 +1,104 @@
+package sudoku
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrInvalidAlphabet     = errors.New("invalid alphabet")
+	ErrUnsupportedAlphabet = errors.New("unsupported alphabet")
+)
+
+var (
+	// DefaultAlphabet uses 1-9 followed by letters, so 16x16 grids use 1-9 and A-G.
+	DefaultAlphabet = NewAlphabet("123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ", 1)
+
+	// ZeroBasedAlphabet uses 0-9 followed by letters, like 0-8 on 9x9 grids or 0-F for hexadoku. Sum rules count the
+	// first symbol as 0.
+	ZeroBasedAlphabet = NewAlphabet("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ", 0)
+)
+
+// Alphabet maps digit values to the symbols used for parsing and printing grids, and to the numbers used by sum rules.
+// Digits are always stored as values from 1 to the grid size, the alphabet only changes how they are read and shown.
+type Alphabet struct {
+	symbols []rune
+	first   int
+}
+
+// NewAlphabet creates an alphabet from the symbols of the digits in ascending order. The first symbol counts as the
+// number first in sum rules, e.g. 0 for 0-8 or 1 for 1-9. Letters are parsed in upper and lower case.
+func NewAlphabet(symbols string, first int) Alphabet {
+	return Alphabet{
+		symbols: []rune(strings.ToUpper(symbols)),
+		first:   first,
+	}
+}
+
+// Symbol returns the symbol used to print the digit v.
+func (a Alphabet) Symbol(v int) rune {
+	if v < 1 || v > len(a.symbols) {
+		return '?'
+	}
+	return a.symbols[v-1]
+}
+
+// Parse returns the digit value for a symbol.
+func (a Alphabet) Parse(r rune) (int, bool) {
+	r = unicode.ToUpper(r)
+	for idx, symbol := range a.symbols {
+		if symbol == r {
+			return idx + 1, true
+		}
+	}
+	return 0, false
+}
+
+// Number returns the number that sum rules use for the digit v.
+func (a Alphabet) Number(v int) int {
+	return v - 1 + a.first
+}
+
+// Value returns the digit value for a number that sum rules use, the opposite of Number.
+func (a Alphabet) Value(n int) int {
+	return n + 1 - a.first
+}
+
+// OneBased reports whether the first digit counts as 1, like in the DefaultAlphabet.
+func (a Alphabet) OneBased() bool {
+	return a.first == 1
+}
+
+// SumValue converts the sum of the numbers of count digits to the sum of their digit values.
+func (a Alphabet) SumValue(sum, count int) int {
+	return sum + count*(1-a.first)
+}
+
+// Format lists the symbols of the digits, separated by commas.
+func Format[D Digits[D]](a Alphabet, d D) string {
+	symbols := make([]string, 0, d.Count())
+	for v := range d.Values {
+		symbols = append(symbols, string(a.Symbol(v)))
+	}
+	return strings.Join(symbols, ",")
+}
+
+// check makes sure that the alphabet has a distinct symbol for every digit of the grid.
+func (a Alphabet) check(size int) error {
+	if len(a.symbols) < size {
+		return fmt.Errorf("%w: %d symbols for %d digits", ErrInvalidAlphabet, len(a.symbols), size)
+	}
+	seen := make(map[rune]bool, size)
+	for _, symbol := range a.symbols[:size] {
+		if unicode.IsSpace(symbol) {
+			return fmt.Errorf("%w: spaces can't be digits", ErrInvalidAlphabet)
+		}
+		if seen[symbol] {
+			return fmt.Errorf("%w: symbol %c is used twice", ErrInvalidAlphabet, symbol)
+		}
+		seen[symbol] = true
+	}
+	return nil
+}
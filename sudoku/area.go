@@ -5,6 +5,18 @@ type AreaOps[A Area[A]] interface {
 	NewAreaFromOffsets(center CellLocation, o Offsets) A
 }
 
+// GeometryOps handle offsets and shifts, which wrap around the edges of toroidal grids.
+type GeometryOps[A Area[A]] interface {
+	// IsToroidal reports whether the grid wraps around at its edges.
+	IsToroidal() bool
+
+	// OffsetLocation returns the cell at the offset from the cell and whether it is part of the grid.
+	OffsetLocation(l CellLocation, offset Offset) (CellLocation, bool)
+
+	// ShiftArea moves all cells of the area by the offset.
+	ShiftArea(a A, offset Offset) A
+}
+
 type Area[A Area[A]] interface {
 	comparable
 
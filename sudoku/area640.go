@@ -0,0 +1,239 @@
+package sudoku
+
+import (
+	"math/bits"
+	"math/rand"
+)
+
+type area640[AS interface {
+	allCells() [10]uint64
+	Size() int
+}] [10]uint64
+
+func (a area640[AS]) And(b area640[AS]) area640[AS] {
+	for i := range a {
+		a[i] &= b[i]
+	}
+	return a
+}
+
+func (a area640[AS]) Or(b area640[AS]) area640[AS] {
+	for i := range a {
+		a[i] |= b[i]
+	}
+	return a
+}
+
+func (a area640[AS]) Not() area640[AS] {
+	var spec AS
+	all := spec.allCells()
+	for i := range a {
+		a[i] = ^a[i] & all[i]
+	}
+	return a
+}
+
+func (a area640[AS]) All() area640[AS] {
+	var spec AS
+	return spec.allCells()
+}
+
+func (a area640[AS]) Size() int {
+	var spec AS
+	return spec.Size()
+}
+
+func (a area640[AS]) With(l CellLocation) area640[AS] {
+	index, mask := a.getMask(l)
+	a[index] = a[index] | mask
+	return a
+}
+
+func (a area640[AS]) Without(l CellLocation) area640[AS] {
+	index, mask := a.getMask(l)
+	a[index] = a[index] & ^mask
+	return a
+}
+
+func (a area640[AS]) getMask(l CellLocation) (int, uint64) {
+	var spec AS
+	idx := l.Row*spec.Size() + l.Col
+	return idx / 64, 1 << (uint64(idx) % 64)
+}
+
+// shiftBitsDown moves every cell n bit positions towards the first cell.
+func (a area640[AS]) shiftBitsDown(n int) (r area640[AS]) {
+	words, shift := n/64, uint64(n%64)
+	for i := range r {
+		src := i + words
+		if src >= len(a) {
+			break
+		}
+		r[i] = a[src] >> shift
+		if shift > 0 && src+1 < len(a) {
+			r[i] |= a[src+1] << (64 - shift)
+		}
+	}
+	return r
+}
+
+// shiftBitsUp moves every cell n bit positions towards the last cell.
+func (a area640[AS]) shiftBitsUp(n int) (r area640[AS]) {
+	words, shift := n/64, uint64(n%64)
+	for i := len(r) - 1; i >= words; i-- {
+		src := i - words
+		r[i] = a[src] << shift
+		if shift > 0 && src > 0 {
+			r[i] |= a[src-1] >> (64 - shift)
+		}
+	}
+	return r.And(r.All())
+}
+
+func (a area640[AS]) ShiftLeft(n int) area640[AS] {
+	var spec AS
+	if n >= spec.Size() {
+		return area640[AS]{}
+	}
+	if n == 0 {
+		return a
+	}
+
+	a = a.shiftBitsDown(n)
+
+	// TODO find faster way to clear bits that wrap around to the next row
+	for row := 0; row < spec.Size(); row++ {
+		for col := spec.Size() - n; col < spec.Size(); col++ {
+			a = a.Without(CellLocation{row, col})
+		}
+	}
+
+	return a
+}
+
+func (a area640[AS]) ShiftRight(n int) area640[AS] {
+	var spec AS
+	if n >= spec.Size() {
+		return area640[AS]{}
+	}
+	if n == 0 {
+		return a
+	}
+
+	a = a.shiftBitsUp(n)
+
+	// TODO find faster way to clear bits that wrap around to the next row
+	for row := 0; row < spec.Size(); row++ {
+		for col := 0; col < n; col++ {
+			a = a.Without(CellLocation{row, col})
+		}
+	}
+
+	return a
+}
+
+func (a area640[AS]) ShiftUp(n int) area640[AS] {
+	var spec AS
+	if n >= spec.Size() {
+		return area640[AS]{}
+	}
+	return a.shiftBitsDown(n * spec.Size())
+}
+
+func (a area640[AS]) ShiftDown(n int) area640[AS] {
+	var spec AS
+	if n >= spec.Size() {
+		return area640[AS]{}
+	}
+	return a.shiftBitsUp(n * spec.Size())
+}
+
+func (a area640[AS]) ShiftBy(offset Offset) area640[AS] {
+	if offset.Row > 0 {
+		a = a.ShiftDown(offset.Row)
+	} else if offset.Row < 0 {
+		a = a.ShiftUp(-offset.Row)
+	}
+	if offset.Col > 0 {
+		a = a.ShiftRight(offset.Col)
+	} else if offset.Col < 0 {
+		a = a.ShiftLeft(-offset.Col)
+	}
+	return a
+}
+
+func (a area640[AS]) Get(l CellLocation) bool {
+	index, mask := a.getMask(l)
+	return a[index]&mask != 0
+}
+
+func (a area640[AS]) Set(l CellLocation, v bool) area640[AS] {
+	if v {
+		return a.With(l)
+	}
+	return a.Without(l)
+}
+
+func (a area640[AS]) Locations(yield func(int, CellLocation) bool) {
+	var spec AS
+	index := 0
+	size := spec.Size()
+	for idx, b := range a {
+		for b != 0 {
+			lz := bits.TrailingZeros64(b)
+			b = b & ^(1 << lz)
+			pos := idx*64 + lz
+			if !yield(index, CellLocation{pos / size, pos % size}) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+func (a area640[AS]) RandomLocation() CellLocation {
+	var spec AS
+	size := spec.Size()
+	return a.nextCell(rand.Intn(size * size))
+}
+
+func (a area640[AS]) nextCell(index int) CellLocation {
+	// drop all cells before the index and return the first remaining one
+	maskedArea := a.shiftBitsDown(index).shiftBitsUp(index)
+	for _, cell := range maskedArea.Locations {
+		return cell
+	}
+	for _, cell := range a.Locations {
+		return cell
+	}
+	return CellLocation{}
+}
+
+func (a area640[AS]) Count() int {
+	count := 0
+	for _, b := range a {
+		count += bits.OnesCount64(b)
+	}
+	return count
+}
+
+func (a area640[AS]) Empty() bool {
+	return a == area640[AS]{}
+}
+
+func (a area640[AS]) String() string {
+	var spec AS
+	size := spec.Size()
+	grid := make([]rune, 0, size*size+size)
+	for row := 0; row < size; row++ {
+		for col := 0; col < size; col++ {
+			if a.Get(CellLocation{row, col}) {
+				grid = append(grid, 'X')
+			} else {
+				grid = append(grid, ' ')
+			}
+		}
+		grid = append(grid, '\n')
+	}
+	return string(grid)
+}
@@ -64,6 +64,17 @@ func runAreaTests[A Area[A]](t *testing.T) {
 		assert.False(t, b.Get(CellLocation{Row: 0, Col: 0}))
 	})
 
+	t.Run("RandomLocation", func(t *testing.T) {
+		t.Parallel()
+
+		var a A
+		a = a.With(CellLocation{Row: 1, Col: 2})
+		a = a.With(CellLocation{Row: a.Size() - 1, Col: a.Size() - 2})
+		for n := 0; n < 20; n++ {
+			assert.True(t, a.Get(a.RandomLocation()))
+		}
+	})
+
 	t.Run("ShiftLeft", func(t *testing.T) {
 		t.Parallel()
 
@@ -121,10 +132,18 @@ func runAreaTests[A Area[A]](t *testing.T) {
 	})
 }
 
+func TestArea4x4(t *testing.T) {
+	runAreaTests[Area4x4](t)
+}
+
 func TestArea6x6(t *testing.T) {
 	runAreaTests[Area6x6](t)
 }
 
+func TestArea8x8(t *testing.T) {
+	runAreaTests[Area8x8](t)
+}
+
 func TestArea9x9(t *testing.T) {
 	runAreaTests[Area9x9](t)
 }
@@ -132,3 +151,11 @@ func TestArea9x9(t *testing.T) {
 func TestArea12x12(t *testing.T) {
 	runAreaTests[Area12x12](t)
 }
+
+func TestArea16x16(t *testing.T) {
+	runAreaTests[Area16x16](t)
+}
+
+func TestArea25x25(t *testing.T) {
+	runAreaTests[Area25x25](t)
+}
@@ -1,5 +1,7 @@
 package sudoku
 
+import "fmt"
+
 type Rule[D Digits[D], A Area[A]] interface {
 	Apply(s SudokuBuilder[D, A]) error
 }
@@ -80,17 +82,23 @@ func (cp OffsetMaskChangeProcessor[D, A]) ProcessChanges(s Sudoku[D, A]) error {
 	for _, cell := range s.ChangedArea().Locations {
 		mask := s.Get(cell)
 		cellMasks := make(map[Offset]D)
+		maskCounts := make(map[Offset]int)
 		for v := range mask.Values {
 			if offsetMasks, ok := cp.offsetMasks[v]; ok {
 				for offset, offsetMask := range offsetMasks {
 					cellMasks[offset] = cellMasks[offset].Or(offsetMask)
+					maskCounts[offset]++
 				}
 			}
 		}
 
 		for offset, combinedMask := range cellMasks {
-			offsetCell := CellLocation{cell.Row + offset.Row, cell.Col + offset.Col}
-			if offsetCell.Row < 0 || offsetCell.Row >= s.Size() || offsetCell.Col < 0 || offsetCell.Col >= s.Size() {
+			// a candidate without a mask for this offset allows all digits
+			if maskCounts[offset] < mask.Count() {
+				continue
+			}
+			offsetCell, ok := s.OffsetLocation(cell, offset)
+			if !ok {
 				continue
 			}
 
@@ -114,17 +122,85 @@ func (r OffsetMaskRestriction[D, A]) MasksForValue(v int) map[Offset]D {
 	return r.offsetMasks[v]
 }
 
+type PairMaskChangeProcessor[D Digits[D], A Area[A]] struct {
+	pairMasks map[CellLocation]map[CellLocation]map[int]D
+}
+
+func (cp PairMaskChangeProcessor[D, A]) Name() string {
+	return "Pair Mask"
+}
+
+func (cp PairMaskChangeProcessor[D, A]) ProcessChanges(s Sudoku[D, A]) error {
+	for _, cell := range s.ChangedArea().Locations {
+		pairs, ok := cp.pairMasks[cell]
+		if !ok {
+			continue
+		}
+		d := s.Get(cell)
+		for other, masks := range pairs {
+			// digits without a mask don't restrict the other cell
+			combinedMask := s.NewDigits()
+			for v := range d.Values {
+				mask, ok := masks[v]
+				if !ok {
+					combinedMask = s.AllDigits()
+					break
+				}
+				combinedMask = combinedMask.Or(mask)
+			}
+			if err := s.Mask(other, combinedMask); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type PairMaskRestriction[D Digits[D], A Area[A]] struct {
+	pairMasks map[CellLocation]map[CellLocation]map[int]D
+}
+
+func (r PairMaskRestriction[D, A]) Name() string {
+	return "Pair Mask Restriction"
+}
+
+// MasksForCell yields the cells that are restricted if the cell contains v, together with their masks.
+func (r PairMaskRestriction[D, A]) MasksForCell(l CellLocation, v int) func(yield func(CellLocation, D) bool) {
+	return func(yield func(CellLocation, D) bool) {
+		for other, masks := range r.pairMasks[l] {
+			if mask, ok := masks[v]; ok {
+				if !yield(other, mask) {
+					return
+				}
+			}
+		}
+	}
+}
+
 type SudokuBuilder[D Digits[D], A Area[A]] interface {
 	BaseSpec
 
 	AreaOps[A]
 	DigitsOps[D]
+	GeometryOps[A]
 
 	buildTarget() Sudoku[D, A]
 
 	Row(row int) A
 	Column(col int) A
 	Box(box int) A
+	BoxAt(l CellLocation) int
+
+	// SetToroidal makes the grid wrap around at its edges. Offset masks and offset exclusions are resolved when
+	// building, so they wrap around no matter if they were added before or after.
+	SetToroidal(toroidal bool)
+
+	// Alphabet returns the symbols and numbers of the digits.
+	Alphabet() Alphabet
+
+	// SetAlphabet changes the symbols and numbers of the digits. Rules read the alphabet when they are applied, so it
+	// fails once cells or rules have been added.
+	SetAlphabet(a Alphabet) error
 
 	SetCell(row, col, value int) error
 	MaskCell(row, col int, mask D) error
@@ -136,15 +212,24 @@ type SudokuBuilder[D Digits[D], A Area[A]] interface {
 	AddChangeProcessor(cp ChangeProcessor[D, A])
 	AddSolveProcessor(sp SolveProcessor[D, A])
 	AddExclusionArea(l CellLocation, a A)
+	// AddOffsetExclusion prevents the cells at the offsets from containing the same digit as the cell. The offsets are
+	// resolved when building, after the geometry of the grid is known.
+	AddOffsetExclusion(l CellLocation, offsets Offsets)
 	AddOffsetMask(v int, offset Offset, mask D)
 
+	// AddPairMask restricts the cell l2 to the mask if the cell l1 contains v.
+	AddPairMask(v int, l1, l2 CellLocation, mask D)
+
 	Build() (Sudoku[D, A], error)
 }
 
 type sudokuBuilder[D Digits[D], A Area[A], G comparable, S size[D, A, G], GO gridOps[D, A, G]] struct {
 	*sudoku[D, A, G, S, GO]
-	solveProcessors SolveProcessors[D, A]
-	offsetMasks     map[int]map[Offset]D
+	solveProcessors  SolveProcessors[D, A]
+	offsetMasks      map[int]map[Offset]D
+	offsetExclusions map[CellLocation]Offsets
+	pairMasks        map[CellLocation]map[CellLocation]map[int]D
+	populated        bool
 }
 
 func newSudokuBuilder[D Digits[D], A Area[A], G comparable, S size[D, A, G], GO gridOps[D, A, G]]() SudokuBuilder[D, A] {
@@ -157,7 +242,9 @@ func newSudokuBuilder[D Digits[D], A Area[A], G comparable, S size[D, A, G], GO
 		solveProcessors: SolveProcessors[D, A]{
 			ExclusionAreaSolveProcessor[D, A]{},
 		},
-		offsetMasks: make(map[int]map[Offset]D),
+		offsetMasks:      make(map[int]map[Offset]D),
+		offsetExclusions: make(map[CellLocation]Offsets),
+		pairMasks:        make(map[CellLocation]map[CellLocation]map[int]D),
 	}
 }
 
@@ -165,11 +252,29 @@ func (s *sudokuBuilder[D, A, G, S, GO]) buildTarget() Sudoku[D, A] {
 	return s.sudoku
 }
 
+func (s *sudokuBuilder[D, A, G, S, GO]) SetToroidal(toroidal bool) {
+	s.toroidal = toroidal
+}
+
+func (s *sudokuBuilder[D, A, G, S, GO]) SetAlphabet(a Alphabet) error {
+	if s.populated {
+		return fmt.Errorf("%w: the alphabet has to be set before cells and rules are added", ErrInvalidAlphabet)
+	}
+	if err := a.check(s.Size()); err != nil {
+		return err
+	}
+	s.alphabet = a
+	s.SetLogger(s.logger)
+	return nil
+}
+
 func (s *sudokuBuilder[D, A, G, S, GO]) SetCell(row, col, value int) error {
+	s.populated = true
 	return s.Set(CellLocation{row, col}, value)
 }
 
 func (s *sudokuBuilder[D, A, G, S, GO]) MaskCell(row, col int, mask D) error {
+	s.populated = true
 	return s.Mask(CellLocation{row, col}, mask)
 }
 
@@ -183,27 +288,38 @@ func (s *sudokuBuilder[D, A, G, S, GO]) Use(rules ...Rule[D, A]) error {
 }
 
 func (s *sudokuBuilder[D, A, G, S, GO]) AddRestriction(r Restriction[D, A]) {
+	s.populated = true
 	s.restrictions = append(s.restrictions, r)
 }
 
 func (s *sudokuBuilder[D, A, G, S, GO]) AddValidator(v Validator[D, A]) {
+	s.populated = true
 	s.validators = append(s.validators, v)
 }
 
 func (s *sudokuBuilder[D, A, G, S, GO]) AddChangeProcessor(cp ChangeProcessor[D, A]) {
+	s.populated = true
 	s.changeProcessors = append(s.changeProcessors, cp)
 }
 
 func (s *sudokuBuilder[D, A, G, S, GO]) AddSolveProcessor(sp SolveProcessor[D, A]) {
+	s.populated = true
 	s.solveProcessors = append(s.solveProcessors, sp)
 }
 
 func (s *sudokuBuilder[D, A, G, S, GO]) AddExclusionArea(l CellLocation, a A) {
+	s.populated = true
 	a = a.Without(l)
 	s.exclusionAreas[l.Row][l.Col] = s.exclusionAreas[l.Row][l.Col].Or(a)
 }
 
+func (s *sudokuBuilder[D, A, G, S, GO]) AddOffsetExclusion(l CellLocation, offsets Offsets) {
+	s.populated = true
+	s.offsetExclusions[l] = append(s.offsetExclusions[l], offsets...)
+}
+
 func (s *sudokuBuilder[D, A, G, S, GO]) AddOffsetMask(v int, offset Offset, mask D) {
+	s.populated = true
 	if s.offsetMasks[v] == nil {
 		s.offsetMasks[v] = make(map[Offset]D)
 	} else if existingMask, ok := s.offsetMasks[v][offset]; ok {
@@ -212,8 +328,24 @@ func (s *sudokuBuilder[D, A, G, S, GO]) AddOffsetMask(v int, offset Offset, mask
 	s.offsetMasks[v][offset] = mask
 }
 
+func (s *sudokuBuilder[D, A, G, S, GO]) AddPairMask(v int, l1, l2 CellLocation, mask D) {
+	s.populated = true
+	if s.pairMasks[l1] == nil {
+		s.pairMasks[l1] = make(map[CellLocation]map[int]D)
+	}
+	if s.pairMasks[l1][l2] == nil {
+		s.pairMasks[l1][l2] = make(map[int]D)
+	} else if existingMask, ok := s.pairMasks[l1][l2][v]; ok {
+		mask = mask.And(existingMask)
+	}
+	s.pairMasks[l1][l2][v] = mask
+}
+
 func (s *sudokuBuilder[D, A, G, S, GO]) Build() (Sudoku[D, A], error) {
 	s.changeProcessors[0] = s.solveProcessors
+	for l, offsets := range s.offsetExclusions {
+		s.AddExclusionArea(l, s.NewAreaFromOffsets(l, offsets))
+	}
 	if len(s.offsetMasks) > 0 {
 		s.changeProcessors = append(s.changeProcessors, OffsetMaskChangeProcessor[D, A]{
 			offsetMasks: s.offsetMasks,
@@ -222,6 +354,14 @@ func (s *sudokuBuilder[D, A, G, S, GO]) Build() (Sudoku[D, A], error) {
 			offsetMasks: s.offsetMasks,
 		})
 	}
+	if len(s.pairMasks) > 0 {
+		s.changeProcessors = append(s.changeProcessors, PairMaskChangeProcessor[D, A]{
+			pairMasks: s.pairMasks,
+		})
+		s.restrictions = append(s.restrictions, PairMaskRestriction[D, A]{
+			pairMasks: s.pairMasks,
+		})
+	}
 	for row := 0; row < s.Size(); row++ {
 		for col := 0; col < s.Size(); col++ {
 			l := CellLocation{row, col}
@@ -110,7 +110,88 @@ func (c digits_16[AD]) String() string {
 			if str != "" {
 				str += ","
 			}
-			str += string(rune(i + '0'))
+			str += string(DigitSymbol(i))
+		}
+	}
+	return str
+}
+
+type digits_32[AD interface{ allDigits() uint32 }] uint32
+
+func (c digits_32[AD]) And(d digits_32[AD]) digits_32[AD] {
+	return c & d
+}
+
+func (c digits_32[AD]) Or(d digits_32[AD]) digits_32[AD] {
+	return c | d
+}
+
+func (c digits_32[AD]) Not() digits_32[AD] {
+	var ad AD
+	return digits_32[AD](ad.allDigits() & ^uint32(c))
+}
+
+func (c digits_32[AD]) All() digits_32[AD] {
+	var ad AD
+	return digits_32[AD](ad.allDigits())
+}
+
+func (c digits_32[AD]) With(v int) digits_32[AD] {
+	return digits_32[AD](uint32(c) | c.getBit(v))
+}
+
+func (c digits_32[AD]) Without(v int) digits_32[AD] {
+	return digits_32[AD](uint32(c) & ^c.getBit(v))
+}
+
+func (c digits_32[AD]) CanContain(v int) bool {
+	return uint32(c)&c.getBit(v) != 0
+}
+
+func (c digits_32[AD]) Empty() bool {
+	return c == 0
+}
+
+func (c digits_32[AD]) Count() int {
+	return bits.OnesCount32(uint32(c))
+}
+
+func (c digits_32[AD]) Single() (v int, isSingle bool) {
+	isSingle = c.Count() == 1
+	if isSingle {
+		v = bits.TrailingZeros32(uint32(c)) + 1
+	}
+	return
+}
+
+func (c digits_32[AD]) getBit(v int) uint32 {
+	return 1 << (v - 1)
+}
+
+func (c digits_32[AD]) Values(yield func(int) bool) {
+	for bit := range iterateBits[digits_32[AD]](c) {
+		if !yield(int(bit) + 1) {
+			return
+		}
+	}
+}
+
+func (c digits_32[AD]) Min() int {
+	return bits.TrailingZeros32(uint32(c)) + 1
+}
+
+func (c digits_32[AD]) Max() int {
+	return 32 - bits.LeadingZeros32(uint32(c))
+}
+
+func (c digits_32[AD]) String() string {
+	str := ""
+	for i := 1; i <= 32; i++ {
+		if c.CanContain(i) {
+			if str != "" {
+				str += ","
+			}
+			str += string(DigitSymbol(i))
 		}
 	}
 	return str
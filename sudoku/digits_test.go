@@ -33,3 +33,69 @@ func TestDigits_16_Max(t *testing.T) {
 		assert.Equal(t, d.Max(), 9)
 	}
 }
+
+func TestDigitSymbols(t *testing.T) {
+	for v := 1; v <= 16; v++ {
+		parsed, ok := ParseDigit(DigitSymbol(v))
+		assert.True(t, ok)
+		assert.Equal(t, v, parsed)
+	}
+
+	v, ok := ParseDigit('g')
+	assert.True(t, ok)
+	assert.Equal(t, 16, v)
+
+	_, ok = ParseDigit(' ')
+	assert.False(t, ok)
+
+	assert.Equal(t, "1,A,G", Digits16(0).With(1).With(10).With(16).String())
+}
+
+func TestAlphabet(t *testing.T) {
+	a := ZeroBasedAlphabet
+	for v := 1; v <= 16; v++ {
+		parsed, ok := a.Parse(a.Symbol(v))
+		assert.True(t, ok)
+		assert.Equal(t, v, parsed)
+	}
+	assert.Equal(t, '0', a.Symbol(1))
+	assert.Equal(t, 'F', a.Symbol(16))
+	assert.Equal(t, 0, a.Number(1))
+	assert.Equal(t, "0,9,F", Format(a, Digits16(0).With(1).With(10).With(16)))
+
+	// three cells with the numbers 0, 1 and 2 have the digit values 1, 2 and 3
+	assert.Equal(t, 6, a.SumValue(3, 3))
+	assert.Equal(t, 6, DefaultAlphabet.SumValue(6, 3))
+
+	words := NewAlphabet("wordshape", 1)
+	v, ok := words.Parse('w')
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	_, ok = words.Parse('1')
+	assert.False(t, ok)
+
+	sb := NewSudokuBuilder9x9()
+	assert.NoError(t, sb.SetAlphabet(words))
+	assert.Equal(t, 'E', sb.Alphabet().Symbol(9))
+	assert.ErrorIs(t, sb.SetAlphabet(NewAlphabet("ABC", 1)), ErrInvalidAlphabet)
+
+	// cells and rules that were already added have used the old alphabet
+	sb = NewSudokuBuilder9x9()
+	assert.NoError(t, sb.SetCell(0, 0, 1))
+	assert.ErrorIs(t, sb.SetAlphabet(words), ErrInvalidAlphabet)
+	sb = NewSudokuBuilder9x9()
+	sb.AddExclusionArea(CellLocation{Row: 0, Col: 0}, sb.Row(0))
+	assert.ErrorIs(t, sb.SetAlphabet(words), ErrInvalidAlphabet)
+}
+
+func TestDigits_32_MinMax(t *testing.T) {
+	d := Digits25(0)
+	for i := 1; i <= 25; i++ {
+		d = d.With(i)
+		assert.Equal(t, 1, d.Min())
+		assert.Equal(t, i, d.Max())
+	}
+	assert.Equal(t, d.All(), d)
+	assert.Equal(t, 25, d.Count())
+	assert.True(t, d.Not().Empty())
+}
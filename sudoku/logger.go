@@ -21,6 +21,11 @@ type Logger[D Digits[D]] interface {
 	ExitContext()
 }
 
+// alphabetLogger is implemented by loggers that print digits with the alphabet of the grid.
+type alphabetLogger interface {
+	setAlphabet(a Alphabet)
+}
+
 type voidLogger[D Digits[D]] struct{}
 
 func (v voidLogger[D]) UpdateCell(loc CellLocation, old, new D) {
@@ -35,10 +40,15 @@ func (v voidLogger[D]) ExitContext() {
 type consoleLogger[D Digits[D]] struct {
 	context             []NamedContext
 	printedContextLevel int
+	alphabet            Alphabet
 }
 
 func NewLogger[D Digits[D]]() Logger[D] {
-	return &consoleLogger[D]{}
+	return &consoleLogger[D]{alphabet: DefaultAlphabet}
+}
+
+func (l *consoleLogger[D]) setAlphabet(a Alphabet) {
+	l.alphabet = a
 }
 
 func (l *consoleLogger[D]) UpdateCell(loc CellLocation, before, after D) {
@@ -47,11 +57,11 @@ func (l *consoleLogger[D]) UpdateCell(loc CellLocation, before, after D) {
 	}
 	l.printContextInfo()
 	if v, isSingle := after.Single(); isSingle {
-		l.printf("solved cell %v to %v", loc, v)
+		l.printf("solved cell %v to %c", loc, l.alphabet.Symbol(v))
 	} else {
 		//removed := before &^ after
 		//removed := new(D)
-		l.printf("removed candidates for cell %v: %s > %s", loc, before, after)
+		l.printf("removed candidates for cell %v: %s > %s", loc, Format(l.alphabet, before), Format(l.alphabet, after))
 	}
 }
 
@@ -3,99 +3,240 @@ package sudoku
 import (
 	"context"
 	"errors"
+	"fmt"
 )
 
+var (
+	ErrNoOverlap           = errors.New("grids don't overlap")
+	ErrGridSizeMismatch    = errors.New("grids have different sizes")
+	ErrInvalidDigitMapping = errors.New("invalid digit mapping")
+)
+
+// MultiSudokuError attributes an error to the grid it occurred in.
+type MultiSudokuError struct {
+	// Grid is the index of the grid in MultiSudoku.Sudokus.
+	Grid int
+	Err  error
+}
+
+func (e MultiSudokuError) Error() string {
+	return fmt.Sprintf("grid %d: %v", e.Grid+1, e.Err)
+}
+
+func (e MultiSudokuError) Unwrap() error {
+	return e.Err
+}
+
+// gridError attributes the error to the grid unless it is already attributed to another grid.
+func gridError(idx int, err error) error {
+	var multiErr MultiSudokuError
+	if errors.As(err, &multiErr) {
+		return err
+	}
+	return MultiSudokuError{Grid: idx, Err: err}
+}
+
+// multiGrids is shared by all overlaps of a multi sudoku and holds the grids they currently write to. Guessing swaps
+// in clones of the grids, so the overlaps must not keep references to the grids themselves.
+type multiGrids[D Digits[D], A Area[A]] struct {
+	sudokus []Sudoku[D, A]
+}
+
 type MultiSudokuBuilder[D Digits[D], A Area[A]] struct {
-	sudokus  []Sudoku[D, A]
-	builders []SudokuBuilder[D, A]
+	grids     *multiGrids[D, A]
+	builders  []SudokuBuilder[D, A]
+	positions []Offset
 }
 
-func (b *MultiSudokuBuilder[D, A]) ensureAdded(sb SudokuBuilder[D, A]) {
+func (b *MultiSudokuBuilder[D, A]) ensureAdded(sb SudokuBuilder[D, A]) (int, bool) {
+	if b.grids == nil {
+		b.grids = &multiGrids[D, A]{}
+	}
 	s := sb.buildTarget()
-	for _, item := range b.sudokus {
+	for idx, item := range b.grids.sudokus {
 		if item == s {
-			return
+			return idx, false
 		}
 	}
-	b.sudokus = append(b.sudokus, s)
+	b.grids.sudokus = append(b.grids.sudokus, s)
 	b.builders = append(b.builders, sb)
+	b.positions = append(b.positions, Offset{})
+	return len(b.grids.sudokus) - 1, true
 }
 
-func (b *MultiSudokuBuilder[D, A]) Overlap(sb1 SudokuBuilder[D, A], corner CellLocation, w, h int, sb2 SudokuBuilder[D, A]) error {
-	b.ensureAdded(sb1)
-	b.ensureAdded(sb2)
+// place adds both grids and derives the position of a new grid in the combined layout from the offset between them.
+func (b *MultiSudokuBuilder[D, A]) place(sb1 SudokuBuilder[D, A], offset Offset, sb2 SudokuBuilder[D, A]) (int, int) {
+	idx1, added1 := b.ensureAdded(sb1)
+	idx2, added2 := b.ensureAdded(sb2)
+	if added2 {
+		b.positions[idx2] = Offset{
+			Row: b.positions[idx1].Row + offset.Row,
+			Col: b.positions[idx1].Col + offset.Col,
+		}
+	} else if added1 {
+		b.positions[idx1] = Offset{
+			Row: b.positions[idx2].Row - offset.Row,
+			Col: b.positions[idx2].Col - offset.Col,
+		}
+	}
+	return idx1, idx2
+}
+
+// Builders returns the builders of all grids in the order they were added.
+func (b *MultiSudokuBuilder[D, A]) Builders() []SudokuBuilder[D, A] {
+	return b.builders
+}
 
+// Use applies the rules to all grids.
+func (b *MultiSudokuBuilder[D, A]) Use(rules ...Rule[D, A]) error {
+	for _, sb := range b.builders {
+		if err := sb.Use(rules...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Overlap links a w by h rectangle at the corner of sb1 with the opposite corner of sb2.
+func (b *MultiSudokuBuilder[D, A]) Overlap(sb1 SudokuBuilder[D, A], corner CellLocation, w, h int, sb2 SudokuBuilder[D, A]) error {
 	offset := Offset{}
 	if corner.Col == 0 {
-		offset.Col = sb1.Size() - w
+		offset.Col = w - sb2.Size()
 	} else if corner.Col == sb1.Size()-1 {
-		offset.Col = w - sb1.Size()
+		offset.Col = sb1.Size() - w
 	} else {
 		return errors.New("invalid corner")
 	}
 
 	if corner.Row == 0 {
-		offset.Row = sb1.Size() - h
+		offset.Row = h - sb2.Size()
 	} else if corner.Row == sb1.Size()-1 {
-		offset.Row = h - sb1.Size()
+		offset.Row = sb1.Size() - h
 	} else {
 		return errors.New("invalid corner")
 	}
 
+	return b.OverlapAt(sb1, offset, sb2)
+}
+
+// OverlapAt places sb2 at the offset relative to the top left cell of sb1 and links all cells the grids share. Grids of
+// different sizes aren't supported and return ErrGridSizeMismatch.
+func (b *MultiSudokuBuilder[D, A]) OverlapAt(sb1 SudokuBuilder[D, A], offset Offset, sb2 SudokuBuilder[D, A]) error {
+	return b.OverlapMapped(sb1, offset, sb2, nil)
+}
+
+// OverlapMapped works like OverlapAt, but digit v in sb1 corresponds to digit mapping[v-1] in sb2. Digits mapped to 0
+// can't be placed in the shared cells. A nil mapping keeps all digits.
+func (b *MultiSudokuBuilder[D, A]) OverlapMapped(sb1 SudokuBuilder[D, A], offset Offset, sb2 SudokuBuilder[D, A], mapping DigitMapping) error {
+	if sb1.Size() != sb2.Size() {
+		return fmt.Errorf("%w: %d and %d", ErrGridSizeMismatch, sb1.Size(), sb2.Size())
+	}
+	if offset.Row >= sb1.Size() || offset.Col >= sb1.Size() || -offset.Row >= sb2.Size() || -offset.Col >= sb2.Size() {
+		return ErrNoOverlap
+	}
+	if err := mapping.validate(sb1.Size()); err != nil {
+		return err
+	}
+
+	idx1, idx2 := b.place(sb1, offset, sb2)
 	sb1.AddChangeProcessor(overlapChangeProcessor[D, A]{
-		targetSudoku: sb2.buildTarget(),
-		offset:       offset,
-	})
-	sb2.AddChangeProcessor(overlapChangeProcessor[D, A]{
-		targetSudoku: sb1.buildTarget(),
+		grids:  b.grids,
+		source: idx1,
+		target: idx2,
 		offset: Offset{
 			Row: -offset.Row,
 			Col: -offset.Col,
 		},
+		mapping: mapping,
+	})
+	sb2.AddChangeProcessor(overlapChangeProcessor[D, A]{
+		grids:   b.grids,
+		source:  idx2,
+		target:  idx1,
+		offset:  offset,
+		mapping: mapping.inverse(sb2.Size()),
 	})
 
 	return nil
 }
 
 type MultiSudoku[D Digits[D], A Area[A]] struct {
-	sudokus []Sudoku[D, A]
+	grids     *multiGrids[D, A]
+	sudokus   []Sudoku[D, A]
+	positions []Offset
+	stats     Stats
 }
 
 func (b *MultiSudokuBuilder[D, A]) Build() (MultiSudoku[D, A], error) {
+	if b.grids == nil {
+		return MultiSudoku[D, A]{}, nil
+	}
 	for idx, sb := range b.builders {
 		s, err := sb.Build()
 		if err != nil {
-			return MultiSudoku[D, A]{}, err
+			return MultiSudoku[D, A]{}, gridError(idx, err)
 		}
-		if s != b.sudokus[idx] {
+		if s != b.grids.sudokus[idx] {
 			panic("invalid sudoku")
 		}
 	}
 	return MultiSudoku[D, A]{
-		sudokus: b.sudokus,
+		grids:     b.grids,
+		sudokus:   b.grids.sudokus,
+		positions: b.positions,
 	}, nil
 }
 
+// Solve solves all grids in turn until none of them makes any more progress.
 func (ms *MultiSudoku[D, A]) Solve(ctx context.Context, factories StrategyFactories[D, A]) error {
-	// TODO repeat until there is no more progress
+	ms.activate()
 	solvers := make([]Solver[D, A], 0, len(ms.sudokus))
 	for _, s := range ms.sudokus {
 		slv := s.NewSolver()
 		slv.Use(factories...)
 		solvers = append(solvers, slv)
 	}
-	//for {
-	//changed := false
-	for _, slv := range solvers {
-		if err := slv.Solve(ctx); err != nil {
-			return err
+
+	for !ms.IsSolved() {
+		cellUpdates := ms.Stats().CellUpdates
+		for idx, slv := range solvers {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			// changes made by overlapping grids haven't been processed yet
+			if err := ms.sudokus[idx].ProcessChanges(); err != nil {
+				return gridError(idx, err)
+			}
+			if err := slv.Solve(ctx); err != nil {
+				return gridError(idx, err)
+			}
+		}
+		if ms.Stats().CellUpdates == cellUpdates {
+			break
 		}
 	}
-	//}
+	return nil
+}
+
+// activate directs all overlaps to the grids of this multi sudoku.
+func (ms *MultiSudoku[D, A]) activate() {
+	if ms.grids != nil {
+		ms.grids.sudokus = ms.sudokus
+	}
+}
+
+func (ms *MultiSudoku[D, A]) clone() *MultiSudoku[D, A] {
+	clone := *ms
+	clone.sudokus = make([]Sudoku[D, A], 0, len(ms.sudokus))
 	for _, s := range ms.sudokus {
-		s.Print()
+		clone.sudokus = append(clone.sudokus, s.clone())
 	}
-	return nil
+	return &clone
+}
+
+// Sudokus returns all grids in the order they were added to the builder.
+func (ms *MultiSudoku[D, A]) Sudokus() []Sudoku[D, A] {
+	return ms.sudokus
 }
 
 func (ms *MultiSudoku[D, A]) IsSolved() bool {
@@ -107,9 +248,60 @@ func (ms *MultiSudoku[D, A]) IsSolved() bool {
 	return true
 }
 
+// Stats returns the combined statistics of all grids.
+func (ms *MultiSudoku[D, A]) Stats() Stats {
+	stats := ms.stats
+	for _, s := range ms.sudokus {
+		stats = stats.add(s.Stats())
+	}
+	return stats
+}
+
+// DigitMapping maps digit v of one grid to digit mapping[v-1] of another grid. 0 marks digits without a counterpart.
+type DigitMapping []int
+
+func (m DigitMapping) validate(size int) error {
+	if m == nil {
+		return nil
+	}
+	if len(m) != size {
+		return fmt.Errorf("%w: expected %d digits, got %d", ErrInvalidDigitMapping, size, len(m))
+	}
+	used := make(map[int]bool, len(m))
+	for _, v := range m {
+		if v == 0 {
+			continue
+		}
+		if v < 0 || v > size {
+			return fmt.Errorf("%w: digit %d out of range", ErrInvalidDigitMapping, v)
+		}
+		if used[v] {
+			return fmt.Errorf("%w: digit %d is used twice", ErrInvalidDigitMapping, v)
+		}
+		used[v] = true
+	}
+	return nil
+}
+
+func (m DigitMapping) inverse(size int) DigitMapping {
+	if m == nil {
+		return nil
+	}
+	inverse := make(DigitMapping, size)
+	for v, mapped := range m {
+		if mapped != 0 {
+			inverse[mapped-1] = v + 1
+		}
+	}
+	return inverse
+}
+
 type overlapChangeProcessor[D Digits[D], A Area[A]] struct {
-	targetSudoku Sudoku[D, A]
-	offset       Offset
+	grids   *multiGrids[D, A]
+	source  int
+	target  int
+	offset  Offset
+	mapping DigitMapping
 }
 
 func (o overlapChangeProcessor[D, A]) Name() string {
@@ -117,20 +309,33 @@ func (o overlapChangeProcessor[D, A]) Name() string {
 }
 
 func (o overlapChangeProcessor[D, A]) ProcessChanges(s Sudoku[D, A]) error {
+	if o.grids.sudokus[o.source] != s {
+		// changes in temporary clones (e.g. made by Try) must not leak into the other grids
+		return nil
+	}
+	target := o.grids.sudokus[o.target]
 	for _, cell := range s.ChangedArea().Locations {
-		mask := s.Get(cell)
 		targetCell := CellLocation{
 			Row: cell.Row + o.offset.Row,
 			Col: cell.Col + o.offset.Col,
 		}
-		if targetCell.Row < 0 || targetCell.Row >= s.Size() {
+		if targetCell.Row < 0 || targetCell.Row >= target.Size() {
 			continue
 		}
-		if targetCell.Col < 0 || targetCell.Col >= s.Size() {
+		if targetCell.Col < 0 || targetCell.Col >= target.Size() {
 			continue
 		}
-		if err := o.targetSudoku.Mask(targetCell, mask); err != nil {
-			return err
+		mask := s.Get(cell)
+		if o.mapping != nil {
+			mask = target.NewDigits()
+			for v := range s.Get(cell).Values {
+				if mapped := o.mapping[v-1]; mapped != 0 {
+					mask = mask.With(mapped)
+				}
+			}
+		}
+		if err := target.Mask(targetCell, mask); err != nil {
+			return MultiSudokuError{Grid: o.target, Err: err}
 		}
 	}
 	return nil
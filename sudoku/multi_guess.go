@@ -0,0 +1,101 @@
+package sudoku
+
+import (
+	"context"
+	"errors"
+)
+
+type MultiGuesser[D Digits[D], A Area[A]] struct {
+	multiSudoku       *MultiSudoku[D, A]
+	strategyFactories StrategyFactories[D, A]
+	guesserRuns       int
+	guessMisses       int
+}
+
+func (ms *MultiSudoku[D, A]) NewGuesser() *MultiGuesser[D, A] {
+	return &MultiGuesser[D, A]{
+		multiSudoku: ms,
+	}
+}
+
+func (g *MultiGuesser[D, A]) Use(factories ...StrategyFactory[D, A]) {
+	g.strategyFactories = append(g.strategyFactories, factories...)
+}
+
+// Guess yields all solutions of the multi sudoku. Guesses are made in the first unsolved grid.
+func (g *MultiGuesser[D, A]) Guess(gs GuessSelector[D, A], ctx context.Context) func(func(*MultiSudoku[D, A]) bool) {
+	return func(yield func(*MultiSudoku[D, A]) bool) {
+		defer g.multiSudoku.activate()
+		g.guesserRuns, g.guessMisses = 0, 0
+
+		base := g.multiSudoku.clone()
+		if err := base.Solve(ctx, g.strategyFactories); err != nil {
+			return
+		}
+		if base.IsSolved() {
+			yield(base)
+			return
+		}
+
+		if gs == nil {
+			gs = DefaultGuessSelector
+		}
+
+		for ms := range g.guessSolutions(base, gs, ctx) {
+			// the solution carries the guesses made so far
+			ms.stats.GuesserRuns += g.guesserRuns
+			ms.stats.GuessMisses += g.guessMisses
+			if !yield(ms) {
+				return
+			}
+		}
+	}
+}
+
+func (g *MultiGuesser[D, A]) guessSolutions(ms *MultiSudoku[D, A], gs GuessSelector[D, A], ctx context.Context) func(yield func(*MultiSudoku[D, A]) bool) {
+	g.guesserRuns++
+	return func(yield func(*MultiSudoku[D, A]) bool) {
+		grid := 0
+		for idx, s := range ms.sudokus {
+			if !s.IsSolved() {
+				grid = idx
+				break
+			}
+		}
+		cell, values := gs(ms.sudokus[grid])
+
+		for v := range values {
+			clone := ms.clone()
+
+			if clone.sudokus[grid].Set(cell, v) != nil {
+				continue
+			}
+
+			if err := clone.Solve(ctx, g.strategyFactories); err != nil {
+				if errors.Is(err, context.DeadlineExceeded) {
+					return
+				}
+				continue
+			}
+
+			if clone.IsSolved() {
+				if !yield(clone) {
+					return
+				}
+			} else {
+				solutionCount := 0
+				for solution := range g.guessSolutions(clone, gs, ctx) {
+					solutionCount++
+					if !yield(solution) {
+						return
+					}
+				}
+				if solutionCount == 0 {
+					g.guessMisses++
+					ms.activate()
+					_ = ms.sudokus[grid].RemoveOption(cell, v)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package sudoku
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MultiSudokuLayout returns the positions of all grids in a combined layout for the given grid and box size.
+type MultiSudokuLayout func(size, boxRows, boxCols int) []Offset
+
+// TwodokuLayout places two grids that share one box at the bottom right corner of the first grid.
+func TwodokuLayout(size, boxRows, boxCols int) []Offset {
+	return []Offset{
+		{Row: 0, Col: 0},
+		{Row: size - boxRows, Col: size - boxCols},
+	}
+}
+
+// SamuraiLayout places four grids around a centre grid, which shares one box at each of its corners.
+func SamuraiLayout(size, boxRows, boxCols int) []Offset {
+	return []Offset{
+		{Row: 0, Col: 0},
+		{Row: 0, Col: 2 * (size - boxCols)},
+		{Row: size - boxRows, Col: size - boxCols},
+		{Row: 2 * (size - boxRows), Col: 0},
+		{Row: 2 * (size - boxRows), Col: 2 * (size - boxCols)},
+	}
+}
+
+// ButterflyLayout places four grids that are shifted by one box against each other.
+func ButterflyLayout(size, boxRows, boxCols int) []Offset {
+	return []Offset{
+		{Row: 0, Col: 0},
+		{Row: 0, Col: boxCols},
+		{Row: boxRows, Col: 0},
+		{Row: boxRows, Col: boxCols},
+	}
+}
+
+// FlowerLayout places four grids around a centre grid, shifted by one box in each direction.
+func FlowerLayout(size, boxRows, boxCols int) []Offset {
+	return []Offset{
+		{Row: 0, Col: boxCols},
+		{Row: boxRows, Col: 0},
+		{Row: boxRows, Col: boxCols},
+		{Row: boxRows, Col: 2 * boxCols},
+		{Row: 2 * boxRows, Col: boxCols},
+	}
+}
+
+// SoheiLayout places four grids in a ring, where neighbouring grids share one box at their corners.
+func SoheiLayout(size, boxRows, boxCols int) []Offset {
+	return []Offset{
+		{Row: 0, Col: size - boxCols},
+		{Row: size - boxRows, Col: 0},
+		{Row: size - boxRows, Col: 2 * (size - boxCols)},
+		{Row: 2 * (size - boxRows), Col: size - boxCols},
+	}
+}
+
+// KazagurumaLayout places four grids around a centre grid like the blades of a pinwheel, each sharing two boxes with
+// the centre grid.
+func KazagurumaLayout(size, boxRows, boxCols int) []Offset {
+	return []Offset{
+		{Row: size - boxRows, Col: size - boxCols},
+		{Row: 0, Col: size - 2*boxCols},
+		{Row: size - 2*boxRows, Col: 2 * (size - boxCols)},
+		{Row: 2 * (size - boxRows), Col: size},
+		{Row: size, Col: 0},
+	}
+}
+
+// CrossLayout places four grids above, below, left and right of a centre grid, each sharing one band of boxes with it.
+func CrossLayout(size, boxRows, boxCols int) []Offset {
+	return []Offset{
+		{Row: size - boxRows, Col: size - boxCols},
+		{Row: 0, Col: size - boxCols},
+		{Row: size - boxRows, Col: 0},
+		{Row: size - boxRows, Col: 2 * (size - boxCols)},
+		{Row: 2 * (size - boxRows), Col: size - boxCols},
+	}
+}
+
+func NewTwodokuBuilder[D Digits[D], A Area[A]](newBuilder func() SudokuBuilder[D, A]) (*MultiSudokuBuilder[D, A], error) {
+	return NewMultiSudokuBuilder(newBuilder, TwodokuLayout)
+}
+
+func NewSamuraiBuilder[D Digits[D], A Area[A]](newBuilder func() SudokuBuilder[D, A]) (*MultiSudokuBuilder[D, A], error) {
+	return NewMultiSudokuBuilder(newBuilder, SamuraiLayout)
+}
+
+func NewButterflyBuilder[D Digits[D], A Area[A]](newBuilder func() SudokuBuilder[D, A]) (*MultiSudokuBuilder[D, A], error) {
+	return NewMultiSudokuBuilder(newBuilder, ButterflyLayout)
+}
+
+func NewFlowerBuilder[D Digits[D], A Area[A]](newBuilder func() SudokuBuilder[D, A]) (*MultiSudokuBuilder[D, A], error) {
+	return NewMultiSudokuBuilder(newBuilder, FlowerLayout)
+}
+
+func NewSoheiBuilder[D Digits[D], A Area[A]](newBuilder func() SudokuBuilder[D, A]) (*MultiSudokuBuilder[D, A], error) {
+	return NewMultiSudokuBuilder(newBuilder, SoheiLayout)
+}
+
+func NewKazagurumaBuilder[D Digits[D], A Area[A]](newBuilder func() SudokuBuilder[D, A]) (*MultiSudokuBuilder[D, A], error) {
+	return NewMultiSudokuBuilder(newBuilder, KazagurumaLayout)
+}
+
+func NewCrossBuilder[D Digits[D], A Area[A]](newBuilder func() SudokuBuilder[D, A]) (*MultiSudokuBuilder[D, A], error) {
+	return NewMultiSudokuBuilder(newBuilder, CrossLayout)
+}
+
+// NewMultiSudokuBuilder creates a grid for every position of the layout and overlaps all grids that share cells.
+func NewMultiSudokuBuilder[D Digits[D], A Area[A]](newBuilder func() SudokuBuilder[D, A], layout MultiSudokuLayout) (*MultiSudokuBuilder[D, A], error) {
+	builders := []SudokuBuilder[D, A]{newBuilder()}
+	size := builders[0].Size()
+	boxRows, boxCols := builders[0].BoxSize()
+	positions := layout(size, boxRows, boxCols)
+	for len(builders) < len(positions) {
+		builders = append(builders, newBuilder())
+	}
+
+	b := &MultiSudokuBuilder[D, A]{}
+	for idx, sb := range builders {
+		b.ensureAdded(sb)
+		b.positions[idx] = positions[idx]
+	}
+	for i := range positions {
+		for j := i + 1; j < len(positions); j++ {
+			offset := Offset{
+				Row: positions[j].Row - positions[i].Row,
+				Col: positions[j].Col - positions[i].Col,
+			}
+			if err := b.OverlapAt(builders[i], offset, builders[j]); err != nil && !errors.Is(err, ErrNoOverlap) {
+				return nil, err
+			}
+		}
+	}
+	return b, nil
+}
+
+// layoutBounds returns the position of the top left cell and the dimensions of the combined layout.
+func layoutBounds(positions []Offset, size int) (origin Offset, rows, cols int) {
+	if len(positions) == 0 {
+		return
+	}
+	origin = positions[0]
+	end := positions[0]
+	for _, p := range positions[1:] {
+		origin.Row = min(origin.Row, p.Row)
+		origin.Col = min(origin.Col, p.Col)
+		end.Row = max(end.Row, p.Row)
+		end.Col = max(end.Col, p.Col)
+	}
+	return origin, end.Row - origin.Row + size, end.Col - origin.Col + size
+}
+
+// SetGivenDigits sets the given digits of all grids from the combined layout. Cells shared by multiple grids are set in
+// all of them.
+func (b *MultiSudokuBuilder[D, A]) SetGivenDigits(rows ...string) error {
+	if len(b.builders) == 0 {
+		return nil
+	}
+	origin, _, _ := layoutBounds(b.positions, b.builders[0].Size())
+	for idx, sb := range b.builders {
+		p := b.positions[idx]
+		for row := 0; row < sb.Size(); row++ {
+			layoutRow := p.Row - origin.Row + row
+			if layoutRow >= len(rows) {
+				break
+			}
+			rowContent := []rune(rows[layoutRow])
+			for col := 0; col < sb.Size(); col++ {
+				layoutCol := p.Col - origin.Col + col
+				if layoutCol >= len(rowContent) {
+					break
+				}
+				if v, ok := sb.Alphabet().Parse(rowContent[layoutCol]); ok {
+					if err := sb.SetCell(row, col, v); err != nil {
+						return fmt.Errorf("grid %d: %w", idx+1, err)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// String returns the combined layout of all grids. Unsolved cells are shown as dots.
+func (ms *MultiSudoku[D, A]) String() string {
+	if len(ms.sudokus) == 0 {
+		return ""
+	}
+	origin, rows, cols := layoutBounds(ms.positions, ms.sudokus[0].Size())
+	layout := make([][]rune, rows)
+	for row := range layout {
+		layout[row] = []rune(strings.Repeat(" ", cols))
+	}
+	for idx, s := range ms.sudokus {
+		p := ms.positions[idx]
+		for row := 0; row < s.Size(); row++ {
+			for col := 0; col < s.Size(); col++ {
+				symbol := '.'
+				if v, ok := s.Get(CellLocation{row, col}).Single(); ok {
+					symbol = s.Alphabet().Symbol(v)
+				}
+				layout[p.Row-origin.Row+row][p.Col-origin.Col+col] = symbol
+			}
+		}
+	}
+
+	sb := strings.Builder{}
+	for _, row := range layout {
+		sb.WriteString(strings.TrimRight(string(row), " "))
+		sb.WriteRune('\n')
+	}
+	return sb.String()
+}
+
+// Print prints the combined layout of all grids.
+func (ms *MultiSudoku[D, A]) Print() {
+	fmt.Print(ms.String())
+}
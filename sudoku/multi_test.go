@@ -28,3 +28,204 @@ func TestMultiSudokuBuilder_Overlap(t *testing.T) {
 	s1.Print()
 	s2.Print()
 }
+
+func TestNewSamuraiBuilder(t *testing.T) {
+	mb, err := NewSamuraiBuilder(NewSudokuBuilder9x9)
+	assert.NoError(t, err)
+	assert.Len(t, mb.Builders(), 5)
+
+	m, err := mb.Build()
+	assert.NoError(t, err)
+	sudokus := m.Sudokus()
+
+	// the centre grid shares one box with each corner grid
+	assert.NoError(t, sudokus[0].Set(CellLocation{8, 8}, 1))
+	assert.NoError(t, sudokus[0].ProcessChanges())
+	assert.Equal(t, sudokus[2].NewDigits(1), sudokus[2].Get(CellLocation{2, 2}))
+
+	assert.NoError(t, sudokus[2].Set(CellLocation{6, 8}, 2))
+	assert.NoError(t, sudokus[2].ProcessChanges())
+	assert.Equal(t, sudokus[4].NewDigits(2), sudokus[4].Get(CellLocation{0, 2}))
+
+	// the corner grids don't share any cells
+	assert.Equal(t, sudokus[1].AllDigits(), sudokus[1].Get(CellLocation{8, 0}))
+}
+
+func TestNewButterflyBuilder(t *testing.T) {
+	mb, err := NewButterflyBuilder(NewSudokuBuilder9x9)
+	assert.NoError(t, err)
+	assert.Len(t, mb.Builders(), 4)
+
+	m, err := mb.Build()
+	assert.NoError(t, err)
+	sudokus := m.Sudokus()
+
+	// the centre cell of the combined layout is part of all four grids
+	assert.NoError(t, sudokus[0].Set(CellLocation{5, 5}, 7))
+	assert.NoError(t, sudokus[0].ProcessChanges())
+	assert.Equal(t, sudokus[1].NewDigits(7), sudokus[1].Get(CellLocation{5, 2}))
+	assert.Equal(t, sudokus[2].NewDigits(7), sudokus[2].Get(CellLocation{2, 5}))
+	assert.Equal(t, sudokus[3].NewDigits(7), sudokus[3].Get(CellLocation{2, 2}))
+}
+
+func TestMultiSudoku_String(t *testing.T) {
+	mb, err := NewTwodokuBuilder(NewSudokuBuilder9x9)
+	assert.NoError(t, err)
+	assert.NoError(t, mb.SetGivenDigits(
+		"1",
+		"",
+		"",
+		"",
+		"",
+		"",
+		"      ..2.....4",
+		"",
+		"",
+		"",
+		"",
+		"",
+		"",
+		"",
+		"              9",
+	))
+
+	m, err := mb.Build()
+	assert.NoError(t, err)
+	assert.Equal(t, m.Sudokus()[1].NewDigits(2), m.Sudokus()[1].Get(CellLocation{0, 2}))
+	assert.Equal(t,
+		"1........\n"+
+			".........\n"+
+			".........\n"+
+			".........\n"+
+			".........\n"+
+			".........\n"+
+			"........2.....4\n"+
+			"...............\n"+
+			"...............\n"+
+			"      .........\n"+
+			"      .........\n"+
+			"      .........\n"+
+			"      .........\n"+
+			"      .........\n"+
+			"      ........9\n",
+		m.String(),
+	)
+}
+
+func TestMultiSudokuBuilder_Build_Error(t *testing.T) {
+	mb, err := NewTwodokuBuilder(NewSudokuBuilder9x9)
+	assert.NoError(t, err)
+	// both givens are in the shared box, so they only contradict each other in the second grid
+	assert.NoError(t, mb.SetGivenDigits(
+		"",
+		"",
+		"",
+		"",
+		"",
+		"",
+		"      1",
+		"",
+		"        1",
+	))
+	sb := mb.Builders()[1]
+	for _, cell := range sb.Box(0).Locations {
+		sb.AddExclusionArea(cell, sb.Box(0))
+	}
+
+	_, err = mb.Build()
+	var multiErr MultiSudokuError
+	assert.ErrorAs(t, err, &multiErr)
+	assert.Equal(t, 1, multiErr.Grid)
+	assert.ErrorAs(t, err, new(ErrEmptyCell))
+}
+
+func TestMultiSudoku_Try(t *testing.T) {
+	mb, err := NewTwodokuBuilder(NewSudokuBuilder9x9)
+	assert.NoError(t, err)
+	m, err := mb.Build()
+	assert.NoError(t, err)
+	sudokus := m.Sudokus()
+
+	// changes in a temporary clone must not reach the other grid
+	assert.NoError(t, sudokus[0].Try(func(s Sudoku[Digits9, Area9x9]) error {
+		if err := s.Set(CellLocation{8, 8}, 5); err != nil {
+			return err
+		}
+		return s.ProcessChanges()
+	}))
+	assert.Equal(t, sudokus[1].AllDigits(), sudokus[1].Get(CellLocation{2, 2}))
+}
+
+func TestMultiSudokuBuilder_OverlapAt(t *testing.T) {
+	sb1 := NewSudokuBuilder9x9()
+	sb2 := NewSudokuBuilder9x9()
+	mb := MultiSudokuBuilder[Digits9, Area9x9]{}
+	// the top three rows of sb2 overlap the bottom three rows of sb1, shifted by one box
+	assert.NoError(t, mb.OverlapAt(sb1, Offset{6, 3}, sb2))
+
+	s1, err := sb1.Build()
+	assert.NoError(t, err)
+	s2, err := sb2.Build()
+	assert.NoError(t, err)
+
+	assert.NoError(t, s1.Set(CellLocation{8, 4}, 1))
+	assert.NoError(t, s1.ProcessChanges())
+	assert.Equal(t, s2.NewDigits(1), s2.Get(CellLocation{2, 1}))
+
+	assert.NoError(t, s2.Mask(CellLocation{0, 5}, s2.NewDigits(3, 4)))
+	assert.NoError(t, s2.ProcessChanges())
+	assert.Equal(t, s1.NewDigits(3, 4), s1.Get(CellLocation{6, 8}))
+
+	// cells outside of the overlap stay untouched
+	assert.NoError(t, s1.Set(CellLocation{8, 0}, 2))
+	assert.NoError(t, s1.ProcessChanges())
+	assert.Equal(t, s2.AllDigits(), s2.Get(CellLocation{2, 0}))
+
+	assert.ErrorIs(t, mb.OverlapAt(sb1, Offset{9, 0}, sb2), ErrNoOverlap)
+	assert.ErrorIs(t, mb.OverlapAt(sb1, Offset{0, -9}, sb2), ErrNoOverlap)
+}
+
+func TestMultiSudokuBuilder_OverlapMapped(t *testing.T) {
+	sb1 := NewSudokuBuilder9x9()
+	sb2 := NewSudokuBuilder9x9()
+	mb := MultiSudokuBuilder[Digits9, Area9x9]{}
+	assert.ErrorIs(t, mb.OverlapMapped(sb1, Offset{6, 6}, sb2, DigitMapping{1, 2, 3}), ErrInvalidDigitMapping)
+	assert.ErrorIs(t, mb.OverlapMapped(sb1, Offset{6, 6}, sb2, DigitMapping{1, 1, 3, 4, 5, 6, 7, 8, 9}), ErrInvalidDigitMapping)
+	assert.ErrorIs(t, mb.OverlapMapped(sb1, Offset{6, 6}, sb2, DigitMapping{1, 2, 3, 4, 5, 6, 7, 8, 10}), ErrInvalidDigitMapping)
+
+	// digits are reversed in sb2 and digit 9 doesn't exist in the shared cells
+	assert.NoError(t, mb.OverlapMapped(sb1, Offset{6, 6}, sb2, DigitMapping{9, 8, 7, 6, 5, 4, 3, 2, 0}))
+
+	s1, err := sb1.Build()
+	assert.NoError(t, err)
+	s2, err := sb2.Build()
+	assert.NoError(t, err)
+	assert.Equal(t, s1.NewDigits(1, 2, 3, 4, 5, 6, 7, 8), s1.Get(CellLocation{6, 6}))
+	assert.Equal(t, s2.NewDigits(2, 3, 4, 5, 6, 7, 8, 9), s2.Get(CellLocation{0, 0}))
+
+	assert.NoError(t, s1.Set(CellLocation{7, 7}, 2))
+	assert.NoError(t, s1.ProcessChanges())
+	assert.Equal(t, s2.NewDigits(8), s2.Get(CellLocation{1, 1}))
+
+	assert.NoError(t, s2.Mask(CellLocation{2, 2}, s2.NewDigits(1, 3, 4)))
+	assert.NoError(t, s2.ProcessChanges())
+	assert.Equal(t, s1.NewDigits(6, 7), s1.Get(CellLocation{8, 8}))
+}
+
+func TestNewKazagurumaBuilder(t *testing.T) {
+	mb, err := NewKazagurumaBuilder(NewSudokuBuilder9x9)
+	assert.NoError(t, err)
+	assert.Len(t, mb.Builders(), 5)
+
+	m, err := mb.Build()
+	assert.NoError(t, err)
+	sudokus := m.Sudokus()
+
+	// the top grid shares the two top left boxes of the centre grid and the right grid the upper two boxes on the right
+	assert.NoError(t, sudokus[0].Set(CellLocation{2, 5}, 4))
+	assert.NoError(t, sudokus[0].ProcessChanges())
+	assert.Equal(t, sudokus[1].NewDigits(4), sudokus[1].Get(CellLocation{8, 8}))
+	assert.NoError(t, sudokus[0].Set(CellLocation{2, 6}, 5))
+	assert.NoError(t, sudokus[0].ProcessChanges())
+	assert.Equal(t, sudokus[2].NewDigits(5), sudokus[2].Get(CellLocation{5, 0}))
+}
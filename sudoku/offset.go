@@ -7,20 +7,53 @@ type Offset struct {
 
 type Offsets []Offset
 
-func (o Offsets) locations(size int, cell CellLocation) func(yield func(cell CellLocation) bool) {
+// locations yields the cells at the offsets from the cell. Offsets that leave the grid are skipped, unless wrap is set,
+// in which case they continue on the opposite side.
+func (o Offsets) locations(size int, cell CellLocation, wrap bool) func(yield func(cell CellLocation) bool) {
 	return func(yield func(cell CellLocation) bool) {
 		for _, offset := range o {
-			row := cell.Row + offset.Row
-			if row < 0 || row >= size {
+			l, ok := offset.apply(size, cell, wrap)
+			if !ok {
 				continue
 			}
-			col := cell.Col + offset.Col
-			if col < 0 || col >= size {
-				continue
-			}
-			if !yield(CellLocation{row, col}) {
+			if !yield(l) {
 				return
 			}
 		}
 	}
 }
+
+// apply returns the cell at the offset from the cell, wrapping around the edges of the grid if wrap is set.
+func (o Offset) apply(size int, cell CellLocation, wrap bool) (CellLocation, bool) {
+	row := cell.Row + o.Row
+	col := cell.Col + o.Col
+	if wrap {
+		return CellLocation{wrapIndex(row, size), wrapIndex(col, size)}, true
+	}
+	if row < 0 || row >= size || col < 0 || col >= size {
+		return CellLocation{}, false
+	}
+	return CellLocation{row, col}, true
+}
+
+func wrapIndex(n, size int) int {
+	n %= size
+	if n < 0 {
+		n += size
+	}
+	return n
+}
+
+// shiftArea moves the area by the offset. Cells that leave the grid are dropped, unless wrap is set, in which case
+// they continue on the opposite side.
+func shiftArea[A Area[A]](a A, size int, offset Offset, wrap bool) A {
+	if !wrap {
+		return a.ShiftBy(offset)
+	}
+	row := wrapIndex(offset.Row, size)
+	col := wrapIndex(offset.Col, size)
+	return a.ShiftBy(Offset{row, col}).
+		Or(a.ShiftBy(Offset{row - size, col})).
+		Or(a.ShiftBy(Offset{row, col - size})).
+		Or(a.ShiftBy(Offset{row - size, col - size}))
+}
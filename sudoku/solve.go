@@ -170,7 +170,7 @@ func (slv *solver[D, A, G, S, GO]) solveExclusionChain(s *sudoku[D, A, G, S, GO]
 
 	for _, cell := range area.Locations {
 		d := s.Get(cell)
-		errs := [9]error{}
+		errs := make([]error, s.Size())
 		for v := range d.Values {
 			clone := *s
 			clone.logger = voidLogger[D]{}
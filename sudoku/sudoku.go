@@ -12,6 +12,7 @@ type Sudoku[D Digits[D], A Area[A]] interface {
 
 	AreaOps[A]
 	DigitsOps[D]
+	GeometryOps[A]
 
 	Row(row int) A
 	Column(col int) A
@@ -58,6 +59,9 @@ type Sudoku[D Digits[D], A Area[A]] interface {
 	// IsUniqueArea checks if all cells in the area require unique digits.
 	IsUniqueArea(area A) bool
 
+	// Alphabet returns the symbols and numbers of the digits.
+	Alphabet() Alphabet
+
 	// SetLogger sets the logger for the Sudoku puzzle.
 	SetLogger(logger Logger[D])
 
@@ -79,6 +83,8 @@ type Sudoku[D Digits[D], A Area[A]] interface {
 
 	getRestrictions() []any
 
+	clone() Sudoku[D, A]
+
 	setSolved(l CellLocation)
 }
 
@@ -98,6 +104,8 @@ type sudoku[D Digits[D], A Area[A], G comparable, S size[D, A, G], GO gridOps[D,
 	validators       []Validator[D, A]
 	changeProcessors []ChangeProcessor[D, A]
 	findAllOptions   bool
+	toroidal         bool
+	alphabet         Alphabet
 	chainLimit       int
 	changed          A
 	nextChanged      A
@@ -115,12 +123,23 @@ type Stats struct {
 	GuessMisses        int
 }
 
+func (s Stats) add(other Stats) Stats {
+	s.CellUpdates += other.CellUpdates
+	s.SolverRuns += other.SolverRuns
+	s.SolverHits += other.SolverHits
+	s.ExclusionChainRuns += other.ExclusionChainRuns
+	s.GuesserRuns += other.GuesserRuns
+	s.GuessMisses += other.GuessMisses
+	return s
+}
+
 func newSudoku[D Digits[D], A Area[A], G comparable, S size[D, A, G], GO gridOps[D, A, G]]() *sudoku[D, A, G, S, GO] {
 	var a A
 
 	s := sudoku[D, A, G, S, GO]{
 		size:        *new(S),
 		chainLimit:  2,
+		alphabet:    DefaultAlphabet,
 		nextChanged: a.All(),
 		logger:      voidLogger[D]{},
 	}
@@ -175,7 +194,7 @@ func (s *sudoku[D, A, G, S, GO]) Box(box int) (a A) {
 
 func (s *sudoku[D, A, G, S, GO]) BoxAt(l CellLocation) int {
 	boxRows, boxCols := s.BoxSize()
-	return (l.Row/boxRows)*boxCols + l.Col/boxCols
+	return (l.Row/boxRows)*(s.Size()/boxCols) + l.Col/boxCols
 }
 
 func (s *sudoku[D, A, G, S, GO]) NewDigits(values ...int) (d D) {
@@ -220,6 +239,12 @@ func (s *sudoku[D, A, G, S, GO]) Try(f func(s Sudoku[D, A]) error) error {
 	return f(&clone)
 }
 
+func (s *sudoku[D, A, G, S, GO]) clone() Sudoku[D, A] {
+	clone := *s
+	clone.logger = voidLogger[D]{}
+	return &clone
+}
+
 // area ops
 func (s *sudoku[D, A, G, S, GO]) NewArea(locs ...CellLocation) (a A) {
 	for _, l := range locs {
@@ -229,12 +254,25 @@ func (s *sudoku[D, A, G, S, GO]) NewArea(locs ...CellLocation) (a A) {
 }
 
 func (s *sudoku[D, A, G, S, GO]) NewAreaFromOffsets(center CellLocation, o Offsets) (a A) {
-	for l := range o.locations(s.Size(), center) {
+	for l := range o.locations(s.Size(), center, s.toroidal) {
 		a = a.With(l)
 	}
 	return a
 }
 
+// geometry ops
+func (s *sudoku[D, A, G, S, GO]) IsToroidal() bool {
+	return s.toroidal
+}
+
+func (s *sudoku[D, A, G, S, GO]) OffsetLocation(l CellLocation, offset Offset) (CellLocation, bool) {
+	return offset.apply(s.Size(), l, s.toroidal)
+}
+
+func (s *sudoku[D, A, G, S, GO]) ShiftArea(a A, offset Offset) A {
+	return shiftArea(a, s.Size(), offset, s.toroidal)
+}
+
 func (s *sudoku[D, A, G, S, GO]) Mask(l CellLocation, d D) error {
 	target := s.GridCell(&s.grid, l.Row, l.Col)
 	if !(*target).And(d.Not()).Empty() {
@@ -320,7 +358,14 @@ func (s *sudoku[D, A, G, S, GO]) IsUniqueArea(area A) bool {
 	return true
 }
 
+func (s *sudoku[D, A, G, S, GO]) Alphabet() Alphabet {
+	return s.alphabet
+}
+
 func (s *sudoku[D, A, G, S, GO]) SetLogger(logger Logger[D]) {
+	if l, ok := logger.(alphabetLogger); ok {
+		l.setAlphabet(s.alphabet)
+	}
 	s.logger = logger
 }
 
@@ -93,3 +93,94 @@ func TestRemoveMask(t *testing.T) {
 	assert.Error(t, s.RemoveMask(loc, s.AllDigits()))
 	assert.Equal(t, s.NewDigits(5), s.Get(loc))
 }
+
+func runBoxAtTest[D Digits[D], A Area[A]](t *testing.T, s Sudoku[D, A]) {
+	boxRows, boxCols := s.BoxSize()
+	for box := 0; box < s.Size(); box++ {
+		assert.Equal(t, boxRows*boxCols, s.Box(box).Count())
+		for _, l := range s.Box(box).Locations {
+			assert.Equal(t, box, s.BoxAt(l))
+		}
+	}
+}
+
+func TestBoxAt(t *testing.T) {
+	s4, err := NewSudoku4x4()
+	assert.NoError(t, err)
+	runBoxAtTest(t, s4)
+
+	s6, err := NewSudoku6x6()
+	assert.NoError(t, err)
+	runBoxAtTest(t, s6)
+
+	s8, err := NewSudoku8x8()
+	assert.NoError(t, err)
+	runBoxAtTest(t, s8)
+
+	s9, err := NewSudoku9x9()
+	assert.NoError(t, err)
+	runBoxAtTest(t, s9)
+
+	s12, err := NewSudoku12x12()
+	assert.NoError(t, err)
+	runBoxAtTest(t, s12)
+}
+
+func TestPairMask(t *testing.T) {
+	sb := NewSudokuBuilder9x9()
+	l1, l2 := CellLocation{Row: 0, Col: 0}, CellLocation{Row: 4, Col: 4}
+	sb.AddPairMask(1, l1, l2, sb.NewDigits(2, 3))
+	sb.AddPairMask(2, l1, l2, sb.NewDigits(3, 4))
+	sb.AddPairMask(2, l1, l2, sb.NewDigits(4, 5))
+	sb.MaskCell(l1.Row, l1.Col, sb.NewDigits(1, 2))
+	s, err := sb.Build()
+	assert.NoError(t, err)
+	assert.Equal(t, s.NewDigits(2, 3, 4), s.Get(l2))
+
+	assert.NoError(t, s.Set(l1, 2))
+	assert.NoError(t, s.ProcessChanges())
+	assert.Equal(t, s.NewDigits(4), s.Get(l2))
+}
+
+func TestOffsetMask(t *testing.T) {
+	sb := NewSudokuBuilder9x9()
+	l1, l2 := CellLocation{Row: 0, Col: 0}, CellLocation{Row: 0, Col: 1}
+	sb.AddOffsetMask(1, Offset{Row: 0, Col: 1}, sb.NewDigits(2).Not())
+	sb.MaskCell(l1.Row, l1.Col, sb.NewDigits(1, 2))
+	s, err := sb.Build()
+	assert.NoError(t, err)
+	// 2 has no mask for this offset, so the neighbour isn't restricted yet
+	assert.Equal(t, s.AllDigits(), s.Get(l2))
+
+	assert.NoError(t, s.Set(l1, 1))
+	assert.NoError(t, s.ProcessChanges())
+	assert.Equal(t, s.NewDigits(2).Not(), s.Get(l2))
+}
+
+func TestToroidal(t *testing.T) {
+	offsets := Offsets{{Row: -1, Col: -1}, {Row: 1, Col: 1}}
+	corner := CellLocation{Row: 0, Col: 0}
+
+	sb := NewSudokuBuilder9x9()
+	assert.Equal(t, sb.NewArea(CellLocation{Row: 1, Col: 1}), sb.NewAreaFromOffsets(corner, offsets))
+	_, ok := sb.OffsetLocation(corner, Offset{Row: -1, Col: 0})
+	assert.False(t, ok)
+
+	sb.SetToroidal(true)
+	assert.True(t, sb.IsToroidal())
+	assert.Equal(t, sb.NewArea(CellLocation{Row: 1, Col: 1}, CellLocation{Row: 8, Col: 8}), sb.NewAreaFromOffsets(corner, offsets))
+	l, ok := sb.OffsetLocation(corner, Offset{Row: -1, Col: 10})
+	assert.True(t, ok)
+	assert.Equal(t, CellLocation{Row: 8, Col: 1}, l)
+
+	a := sb.NewArea(CellLocation{Row: 0, Col: 0}, CellLocation{Row: 8, Col: 4})
+	assert.Equal(t, sb.NewArea(CellLocation{Row: 8, Col: 1}, CellLocation{Row: 7, Col: 5}), sb.ShiftArea(a, Offset{Row: -1, Col: 1}))
+	assert.Equal(t, a, sb.ShiftArea(a, Offset{Row: 9, Col: -18}))
+
+	sb.AddOffsetMask(1, Offset{Row: 0, Col: -1}, sb.NewDigits(1).Not())
+	sb.SetCell(0, 0, 1)
+	s, err := sb.Build()
+	assert.NoError(t, err)
+	assert.True(t, s.IsToroidal())
+	assert.Equal(t, sb.NewDigits(1).Not(), s.Get(CellLocation{Row: 0, Col: 8}))
+}
@@ -33,14 +33,6 @@ func PrintGrid[D Digits[D], A Area[A]](s Sudoku[D, A]) {
 	boxLine := createLine('╠', '╪', '═', '╬', '╣')
 	bottomLine := createLine('╚', '╧', '═', '╩', '╝')
 
-	symbols := make([]rune, 0, 36)
-	for r := '1'; r <= '9'; r++ {
-		symbols = append(symbols, r)
-	}
-	for r := 'A'; r <= 'Z'; r++ {
-		symbols = append(symbols, r)
-	}
-
 	fmt.Println(topLine)
 	for row := 0; row < gridSize; row++ {
 		for subRow := 0; subRow < boxRows; subRow++ {
@@ -55,7 +47,7 @@ func PrintGrid[D Digits[D], A Area[A]](s Sudoku[D, A]) {
 				cell := s.Get(CellLocation{Row: row, Col: col})
 				for digit := subRow * boxCols; digit < (subRow+1)*boxCols; digit++ {
 					if cell.CanContain(digit + 1) {
-						line = append(line, symbols[digit])
+						line = append(line, s.Alphabet().Symbol(digit+1))
 					} else {
 						line = append(line, ' ')
 					}
@@ -74,6 +66,25 @@ func PrintGrid[D Digits[D], A Area[A]](s Sudoku[D, A]) {
 	fmt.Println(bottomLine)
 }
 
+// DigitSymbol returns the symbol used to print the digit v with the DefaultAlphabet.
+func DigitSymbol(v int) rune {
+	return DefaultAlphabet.Symbol(v)
+}
+
+// ParseDigit returns the digit value for a symbol of the DefaultAlphabet. Letters are accepted in upper and lower case.
+func ParseDigit(r rune) (int, bool) {
+	return DefaultAlphabet.Parse(r)
+}
+
+// DigitRange returns all digits from low to high. Values outside of the grid are ignored.
+func DigitRange[D Digits[D]](ops DigitsOps[D], low, high int) D {
+	d := ops.NewDigits()
+	for v := max(low, 1); v <= high; v++ {
+		d = d.With(v)
+	}
+	return d.And(ops.AllDigits())
+}
+
 func GetRestrictions[D Digits[D], A Area[A], R Restriction[D, A]](s Sudoku[D, A]) func(yield func(R) bool) {
 	return func(yield func(R) bool) {
 		for _, restriction := range s.getRestrictions() {
@@ -0,0 +1,43 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+func TestAlphadoku(t *testing.T) {
+	SudokuTests[sudoku.Digits25, sudoku.Area25x25]{
+		"alphadoku": {
+			rule.ClassicRules[sudoku.Digits25, sudoku.Area25x25]{},
+			rule.GivenDigitsFromString[sudoku.Digits25, sudoku.Area25x25](
+				"BF  27 9 N 1I CDM G38EL  ",
+				" ELOKBFH 2  9J P1I  DM  3",
+				"  9   1I6 D 5   E OKBFH42",
+				"    38  O BFH4    J  1I C",
+				" 1 6CD     ELO B H4 7A J ",
+				"KB H427A  NP I  DM G 8 LO",
+				"2      1    M5G  E   BF 4",
+				"   I6C M G3 E  KB H 2 A J",
+				"C  5 3 E O BFH 27A  N 1I ",
+				"38 LOK F    A J   I CD 5G",
+				" JNP1   D  G 8E     H   A",
+				" 4    J P I6 D 5G 8 LOK  ",
+				" 6CDM G3 EL  BF  2   JNP ",
+				"   B H427  JNP1I6CDM5 3  ",
+				"5G 8  OK FH427A  N 1I  D ",
+				"F      J  1I6C M5G3     B",
+				"       O        9JNP1I6 D",
+				"1I  DM5 38  O  F 4 7 9J P",
+				"  JNP1    M  3   OK F  2 ",
+				"ELO B H  7A  N 1 6C   G38",
+				"6CD  G 8    B H4  A  NP1I",
+				"     J   I   M5 38  OKB H",
+				"G  ELO B   27     1I6CDM5",
+				"  P1I   M G3  L K  H 27  ",
+				" K  H 27 9    I6 DM  38E ",
+			),
+		},
+	}.Run(t, sudoku.NewSudokuBuilder25x25)
+}
@@ -0,0 +1,66 @@
+package test
+
+import (
+	"testing"
+
+	extraRule "github.com/lumaraf/sudoku-solver/extra/rule"
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArrow(t *testing.T) {
+	arrows, err := extraRule.ArrowRulesFromPaths[sudoku.Digits9, sudoku.Area9x9](
+		"r4c8 r4c9: r3c9 r3c8 r2c8",
+		"r5c9: r6c8 r5c7",
+		"r1c1: r1c2 r2c3 r1c4",
+		"r5c1: r5c2 r5c3",
+		"r2c5: r3c6 r2c6 r1c5",
+		"r5c6: r4c5 r4c6 r5c5",
+	)
+	assert.NoError(t, err)
+
+	SudokuTests[sudoku.Digits9, sudoku.Area9x9]{
+		"arrow": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				"         ",
+				"         ",
+				"    7 3  ",
+				"  4   8  ",
+				"5        ",
+				" 61      ",
+				"     7 6 ",
+				" 5 1   3 ",
+				"4 3      ",
+			),
+			arrows,
+		},
+	}.Run(t, sudoku.NewSudokuBuilder9x9)
+}
+
+func TestArrow_Invalid(t *testing.T) {
+	_, err := extraRule.ArrowRulesFromPaths[sudoku.Digits9, sudoku.Area9x9]("r1c1 r1c2 r1c3")
+	assert.ErrorIs(t, err, extraRule.ErrInvalidCellPath)
+
+	arrows, err := extraRule.ArrowRulesFromPaths[sudoku.Digits9, sudoku.Area9x9]("r1c1: r1c3 r1c4")
+	assert.NoError(t, err)
+	sb := sudoku.NewSudokuBuilder9x9()
+	assert.ErrorIs(t, sb.Use(arrows), extraRule.ErrInvalidArrow)
+
+	pills, err := extraRule.ArrowRulesFromPaths[sudoku.Digits16, sudoku.Area16x16]("r1c1 r1c2: r2c3 r3c3")
+	assert.NoError(t, err)
+	assert.ErrorIs(t, sudoku.NewSudokuBuilder16x16().Use(pills), extraRule.ErrInvalidArrow)
+}
+
+func TestArrow_PillOrder(t *testing.T) {
+	// the pill reads 12 even though its cells are listed from right to left, two cells can't add up to 21
+	arrows, err := extraRule.ArrowRulesFromPaths[sudoku.Digits9, sudoku.Area9x9]("r1c2 r1c1: r2c2 r2c3")
+	assert.NoError(t, err)
+	_, err = sudoku.NewSudoku9x9(
+		rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+		arrows,
+		rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9]("12"),
+	)
+	assert.NoError(t, err)
+}
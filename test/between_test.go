@@ -0,0 +1,41 @@
+package test
+
+import (
+	"testing"
+
+	extraRule "github.com/lumaraf/sudoku-solver/extra/rule"
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBetweenLine(t *testing.T) {
+	lines, err := extraRule.BetweenLineRulesFromPaths[sudoku.Digits9, sudoku.Area9x9](
+		"r7c7 r8c8 r7c9 r6c9 r5c9",
+		"r2c7 r1c7 r1c8 r1c9 r2c9",
+		"r8c1 r9c2 r9c3 r8c3 r7c2",
+		"r2c8 r3c8 r3c7 r4c6 r4c5",
+		"r3c4 r4c3 r3c3 r2c4 r1c4",
+		"r4c7 r5c6 r6c5 r7c4 r6c3",
+		"r2c5 r1c5 r2c6 r3c5 r3c6",
+	)
+	assert.NoError(t, err)
+
+	SudokuTests[sudoku.Digits9, sudoku.Area9x9]{
+		"between line": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				"     4   ",
+				"    8    ",
+				"6       4",
+				" 9       ",
+				"     67  ",
+				"8      2 ",
+				"   4     ",
+				"     8 3 ",
+				" 73   1  ",
+			),
+			lines,
+		},
+	}.Run(t, sudoku.NewSudokuBuilder9x9)
+}
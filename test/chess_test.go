@@ -6,6 +6,7 @@ import (
 	extraRule "github.com/lumaraf/sudoku-solver/extra/rule"
 	"github.com/lumaraf/sudoku-solver/rule"
 	"github.com/lumaraf/sudoku-solver/sudoku"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestChess(t *testing.T) {
@@ -25,6 +26,37 @@ func TestChess(t *testing.T) {
 			),
 			extraRule.AntiKnightRule[sudoku.Digits9, sudoku.Area9x9]{},
 		},
+		"toroidal anti knight": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				" 8       ",
+				"  9      ",
+				"57     3 ",
+				"4    2   ",
+				"         ",
+				"         ",
+				"         ",
+				"        8",
+				"  6      ",
+			),
+			rule.ToroidalRule[sudoku.Digits9, sudoku.Area9x9]{},
+			extraRule.AntiKnightRule[sudoku.Digits9, sudoku.Area9x9]{},
+		},
+		"anti queen": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				"   6    2",
+				"1 7      ",
+				"4    7 36",
+				"2    5   ",
+				"  432  5 ",
+				"  1     3",
+				" 8       ",
+				"    1   5",
+				"  357    ",
+			),
+			extraRule.AntiQueenRule[sudoku.Digits9, sudoku.Area9x9]{Digits: []int{9}},
+		},
 		"miracle": {
 			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
 			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
@@ -60,3 +92,24 @@ func TestChess(t *testing.T) {
 		},
 	}.Run(t, sudoku.NewSudokuBuilder9x9)
 }
+
+func TestChess_ToroidalOrder(t *testing.T) {
+	corner := sudoku.CellLocation{Row: 0, Col: 0}
+	var exclusions []sudoku.Area9x9
+	for _, rules := range [][]sudoku.Rule[sudoku.Digits9, sudoku.Area9x9]{
+		{rule.ToroidalRule[sudoku.Digits9, sudoku.Area9x9]{}, extraRule.AntiKnightRule[sudoku.Digits9, sudoku.Area9x9]{}},
+		{extraRule.AntiKnightRule[sudoku.Digits9, sudoku.Area9x9]{}, rule.ToroidalRule[sudoku.Digits9, sudoku.Area9x9]{}},
+	} {
+		s, err := sudoku.NewSudoku9x9(rules...)
+		assert.NoError(t, err)
+		exclusions = append(exclusions, s.GetExclusionArea(corner))
+	}
+	assert.Equal(t, 8, exclusions[0].Count())
+	assert.Equal(t, exclusions[0], exclusions[1])
+}
+
+func TestChess_InvalidDigit(t *testing.T) {
+	sb := sudoku.NewSudokuBuilder9x9()
+	err := sb.Use(extraRule.AntiQueenRule[sudoku.Digits9, sudoku.Area9x9]{Digits: []int{10}})
+	assert.ErrorIs(t, err, sudoku.ErrValueOutOfRange)
+}
@@ -0,0 +1,73 @@
+package test
+
+import (
+	"testing"
+
+	extraRule "github.com/lumaraf/sudoku-solver/extra/rule"
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+	"github.com/stretchr/testify/assert"
+)
+
+func cloneRule(t *testing.T, transform extraRule.Transform, anti bool, source, target string) sudoku.Rule[sudoku.Digits9, sudoku.Area9x9] {
+	r, err := extraRule.CloneRuleFromPaths[sudoku.Digits9, sudoku.Area9x9](transform, anti, source, target)
+	assert.NoError(t, err)
+	return r
+}
+
+func TestClone(t *testing.T) {
+	SudokuTests[sudoku.Digits9, sudoku.Area9x9]{
+		"clone": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				"       2 ",
+				" 47      ",
+				"31 8   57",
+				"7        ",
+				"         ",
+				"  9     8",
+				"         ",
+				"         ",
+				"  63     ",
+			),
+			cloneRule(t, extraRule.Rotate180, false, "r1c1 r1c2 r1c3 r2c1 r2c2", "r9c9 r9c8 r9c7 r8c9 r8c8"),
+			cloneRule(t, extraRule.Translate, false, "r4c2 r5c2 r5c3 r6c3", "r1c5 r2c5 r2c6 r3c6"),
+			cloneRule(t, extraRule.Rotate90, false, "r4c8 r5c8 r5c9", "r7c3 r7c4 r8c3"),
+		},
+		"anti clone": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				" 6  8   9",
+				" 41     5",
+				"7    9 1 ",
+				"2    8   ",
+				"    3    ",
+				"6 31    2",
+				" 5 4     ",
+				"        1",
+				"   873 4 ",
+			),
+			cloneRule(t, extraRule.MirrorColumns, true,
+				"r1c1 r1c2 r1c3 r2c1 r2c2 r2c3 r3c1 r3c2 r3c3",
+				"r7c7 r7c8 r7c9 r8c7 r8c8 r8c9 r9c7 r9c8 r9c9",
+			),
+		},
+	}.Run(t, sudoku.NewSudokuBuilder9x9)
+}
+
+func TestClone_Invalid(t *testing.T) {
+	for name, target := range map[string]string{
+		"different size":  "r9c9 r9c8",
+		"different shape": "r8c8 r8c9 r9c9",
+		"outside":         "r9c9 r9c10 r10c10",
+	} {
+		sb := sudoku.NewSudokuBuilder9x9()
+		err := sb.Use(cloneRule(t, extraRule.Translate, false, "r1c1 r1c2 r2c1", target))
+		assert.ErrorIs(t, err, extraRule.ErrInvalidClone, name)
+	}
+
+	// the middle cell of a turned row maps onto itself
+	sb := sudoku.NewSudokuBuilder9x9()
+	err := sb.Use(cloneRule(t, extraRule.Rotate180, true, "r1c1 r1c2 r1c3", "r1c1 r1c2 r1c3"))
+	assert.ErrorIs(t, err, extraRule.ErrInvalidClone)
+}
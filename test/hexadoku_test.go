@@ -0,0 +1,56 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+func TestHexadoku(t *testing.T) {
+	SudokuTests[sudoku.Digits16, sudoku.Area16x16]{
+		"hexadoku": {
+			rule.ClassicRules[sudoku.Digits16, sudoku.Area16x16]{},
+			rule.GivenDigitsFromString[sudoku.Digits16, sudoku.Area16x16](
+				"83156CDB E4 7A9G",
+				"F 42   G 3 5   B",
+				"7A  8  56 DB  42",
+				" CD  E4   9 8  5",
+				"  E 2 A  83 5   ",
+				"5  DB E 27 9G8 1",
+				"2 A G  156CD  E ",
+				"G831 6CD FE42 A9",
+				" 56 DBFE427  G83",
+				"  F 42     3 56 ",
+				" 27A9 83 5   BF ",
+				"9G8 15 CDB E42 A",
+				"E42  9 8  5 C B ",
+				"C B E42  9G  1  ",
+				"315 C BFE4 7A G8",
+				"    31 6 D FE 27",
+			),
+		},
+		"hexadoku 0-F": {
+			rule.AlphabetRule[sudoku.Digits16, sudoku.Area16x16]{Alphabet: sudoku.ZeroBasedAlphabet},
+			rule.ClassicRules[sudoku.Digits16, sudoku.Area16x16]{},
+			rule.GivenDigitsFromString[sudoku.Digits16, sudoku.Area16x16](
+				"72045BCA D3 698F",
+				"E 31   F 2 4   A",
+				"69  7  45 CA  31",
+				" BC  D3   8 7  4",
+				"  D 1 9  72 4   ",
+				"4  CA D 16 8F7 0",
+				"1 9 F  045BC  D ",
+				"F720 5BC ED31 98",
+				" 45 CAED316  F72",
+				"  E 31     2 45 ",
+				" 1698 72 4   AE ",
+				"8F7 04 BCA D31 9",
+				"D31  8 7  4 B A ",
+				"B A D31  8F  0  ",
+				"204 B AED3 69 F7",
+				"    20 5 C ED 16",
+			),
+		},
+	}.Run(t, sudoku.NewSudokuBuilder16x16)
+}
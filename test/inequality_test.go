@@ -0,0 +1,68 @@
+package test
+
+import (
+	"testing"
+
+	extraRule "github.com/lumaraf/sudoku-solver/extra/rule"
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInequality6x6(t *testing.T) {
+	SudokuTests[sudoku.Digits6, sudoku.Area6x6]{
+		"inequality": {
+			rule.ClassicRules[sudoku.Digits6, sudoku.Area6x6]{},
+			extraRule.InequalityRuleFromString[sudoku.Digits6, sudoku.Area6x6](
+				".<.<.>.<.>.",
+				"^ ^ v ^ v ^",
+				".>.>.<.>.<.",
+				"v v ^ v ^ ^",
+				".>.<.>.>.<.",
+				"^ ^ v v ^ ^",
+				".>.<.>.<.<.",
+				"v ^ v ^ ^ v",
+				".<.>.<.<.>.",
+				"v v ^ ^ v v",
+				".<.>.<.>.>.",
+			),
+		},
+	}.Run(t, sudoku.NewSudokuBuilder6x6)
+}
+
+func TestInequality9x9(t *testing.T) {
+	SudokuTests[sudoku.Digits9, sudoku.Area9x9]{
+		"inequality": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			extraRule.InequalityRuleFromString[sudoku.Digits9, sudoku.Area9x9](
+				".>.<. .<.<. .<.>.",
+				"^ ^ v ^ ^ v v ^ ^",
+				".<.>. .<.>. .<.>.",
+				"v ^ ^ ^ v ^ ^ v v",
+				".<.>. .>.<. .<.>.",
+				"                 ",
+				".>.<. .<.>. .<.<.",
+				"v ^ v ^ v v ^ v v",
+				".<.>. .<.>. .>.<.",
+				"^ v v ^ v ^ v ^ ^",
+				".>.>. .>.>. .>.<.",
+				"                 ",
+				".>.<. .>.<. .>.>.",
+				"v ^ v ^ ^ ^ v v ^",
+				".<.<. .>.<. .<.<.",
+				"^ ^ v v v ^ ^ ^ ^",
+				".>.>. .>.<. .<.<.",
+			),
+		},
+	}.Run(t, sudoku.NewSudokuBuilder9x9)
+}
+
+func TestInequality_Cycle(t *testing.T) {
+	sb := sudoku.NewSudokuBuilder9x9()
+	err := sb.Use(extraRule.InequalityRuleFromString[sudoku.Digits9, sudoku.Area9x9](
+		".<.",
+		"v ^",
+		".>.",
+	))
+	assert.ErrorIs(t, err, extraRule.ErrInvalidInequality)
+}
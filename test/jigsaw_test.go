@@ -0,0 +1,37 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+func TestJigsaw(t *testing.T) {
+	SudokuTests[sudoku.Digits9, sudoku.Area9x9]{
+		"jigsaw": {
+			rule.JigsawRulesFromString[sudoku.Digits9, sudoku.Area9x9](
+				"AAAABBBBB",
+				"AAACCCBBB",
+				"ADDDCCCBE",
+				"ADDDCCFEE",
+				"GDDDCFFFE",
+				"GGGHHFFEE",
+				"GGHHHFFEE",
+				"GGHHIFIIE",
+				"GHHIIIIII",
+			),
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				"        8",
+				"  7      ",
+				" 8 1     ",
+				"      3 9",
+				"24   7   ",
+				" 9      7",
+				"    9    ",
+				"      6 3",
+				"     2   ",
+			),
+		},
+	}.Run(t, sudoku.NewSudokuBuilder9x9)
+}
@@ -0,0 +1,112 @@
+package test
+
+import (
+	"testing"
+
+	extraRule "github.com/lumaraf/sudoku-solver/extra/rule"
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+	"github.com/stretchr/testify/assert"
+)
+
+func arithmeticCages[D sudoku.Digits[D], A sudoku.Area[A]](t *testing.T, grid []string, clues map[rune]string) sudoku.Rules[D, A] {
+	cages, err := extraRule.ArithmeticCageRulesFromString[D, A](grid, clues)
+	assert.NoError(t, err)
+	return cages
+}
+
+func TestKenKen4x4(t *testing.T) {
+	SudokuTests[sudoku.Digits4, sudoku.Area4x4]{
+		"kenken": {
+			rule.LatinSquareRules[sudoku.Digits4, sudoku.Area4x4]{},
+			arithmeticCages[sudoku.Digits4, sudoku.Area4x4](t,
+				[]string{
+					"EAAF",
+					"CCHF",
+					"CCBB",
+					"GDDB",
+				},
+				map[rune]string{
+					'A': "2-",
+					'B': "6x",
+					'C': "10+",
+					'D': "4+",
+					'E': "1",
+					'F': "1-",
+					'G': "4",
+					'H': "2",
+				},
+			),
+		},
+	}.Run(t, sudoku.NewSudokuBuilder4x4)
+}
+
+func TestKenKen6x6(t *testing.T) {
+	grid := []string{
+		"FFNAAA",
+		"BBNAMG",
+		"JHHEEG",
+		"JDKKCC",
+		"JDKIOC",
+		"JPIILL",
+	}
+	SudokuTests[sudoku.Digits6, sudoku.Area6x6]{
+		"kenken": {
+			rule.LatinSquareRules[sudoku.Digits6, sudoku.Area6x6]{},
+			arithmeticCages[sudoku.Digits6, sudoku.Area6x6](t, grid, map[rune]string{
+				'A': "13+",
+				'B': "4-",
+				'C': "9+",
+				'D': "6/",
+				'E': "6/",
+				'F': "8+",
+				'G': "11+",
+				'H': "2/",
+				'I': "18x",
+				'J': "15+",
+				'K': "11+",
+				'L': "2-",
+				'M': "2",
+				'N': "1-",
+				'O': "4",
+				'P': "2",
+			}),
+		},
+		"kenken without operators": {
+			rule.LatinSquareRules[sudoku.Digits6, sudoku.Area6x6]{},
+			arithmeticCages[sudoku.Digits6, sudoku.Area6x6](t, grid, map[rune]string{
+				'A': "48",
+				'B': "5",
+				'C': "9",
+				'D': "5",
+				'E': "6",
+				'F': "2",
+				'G': "11",
+				'H': "2",
+				'I': "18",
+				'J': "15",
+				'K': "11",
+				'L': "2",
+				'M': "2",
+				'N': "7",
+				'O': "4",
+				'P': "2",
+			}),
+		},
+	}.Run(t, sudoku.NewSudokuBuilder6x6)
+}
+
+func TestKenKen_Invalid(t *testing.T) {
+	_, err := extraRule.ArithmeticCageRulesFromString[sudoku.Digits6, sudoku.Area6x6]([]string{"AA"}, map[rune]string{'A': "x"})
+	assert.ErrorIs(t, err, extraRule.ErrInvalidArithmeticCage)
+
+	cages, err := extraRule.ArithmeticCageRulesFromString[sudoku.Digits6, sudoku.Area6x6]([]string{"AAA"}, map[rune]string{'A': "1-"})
+	assert.NoError(t, err)
+	sb := sudoku.NewSudokuBuilder6x6()
+	assert.ErrorIs(t, sb.Use(cages), extraRule.ErrInvalidArithmeticCage)
+
+	cages, err = extraRule.ArithmeticCageRulesFromString[sudoku.Digits6, sudoku.Area6x6]([]string{"AA"}, map[rune]string{'A': "7x"})
+	assert.NoError(t, err)
+	sb = sudoku.NewSudokuBuilder6x6()
+	assert.ErrorIs(t, sb.Use(cages), extraRule.ErrInvalidArithmeticCage)
+}
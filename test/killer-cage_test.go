@@ -6,6 +6,7 @@ import (
 	extraRule "github.com/lumaraf/sudoku-solver/extra/rule"
 	"github.com/lumaraf/sudoku-solver/rule"
 	"github.com/lumaraf/sudoku-solver/sudoku"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestKillerCage(t *testing.T) {
@@ -54,6 +55,51 @@ func TestKillerCage(t *testing.T) {
 				},
 			),
 		},
+		"daily killer #6502 with digits 0-8": {
+			rule.AlphabetRule[sudoku.Digits9, sudoku.Area9x9]{Alphabet: sudoku.ZeroBasedAlphabet},
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			extraRule.KillerCageRulesFromString[sudoku.Digits9, sudoku.Area9x9](
+				[]string{
+					"AAAAGOOVV",
+					"BBHHGPOVV",
+					"CBIIPPWWW",
+					"CDIIQQQQX",
+					"DDJJRRRRX",
+					"EKKKSTTYX",
+					"ELLLSTTYX",
+					"FFMMSUUYZ",
+					"FFNNNNUZZ",
+				},
+				map[rune]int{
+					'A': 7,
+					'B': 18,
+					'C': 10,
+					'D': 10,
+					'E': 5,
+					'F': 15,
+					'G': 10,
+					'H': 7,
+					'I': 21,
+					'J': 7,
+					'K': 6,
+					'L': 16,
+					'M': 10,
+					'N': 20,
+					'O': 13,
+					'P': 9,
+					'Q': 17,
+					'R': 11,
+					'S': 16,
+					'T': 21,
+					'U': 9,
+					'V': 15,
+					'W': 16,
+					'X': 15,
+					'Y': 11,
+					'Z': 9,
+				},
+			),
+		},
 		//"besties 2": { // not yet solvable without guessing
 		//	rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
 		//	extraRule.AntiKnightRule[sudoku.Digits9, sudoku.Area9x9]{},
@@ -97,3 +143,116 @@ func TestKillerCage(t *testing.T) {
 		//},
 	}.Run(t, sudoku.NewSudokuBuilder9x9)
 }
+
+func killerCageClues(t *testing.T, clues map[rune]string) sudoku.Rules[sudoku.Digits9, sudoku.Area9x9] {
+	rules, err := extraRule.KillerCageRulesFromClues[sudoku.Digits9, sudoku.Area9x9](
+		[]string{
+			"AAAAGOOVV",
+			"BBHHGPOVV",
+			"CBIIPPWWW",
+			"CDIIQQQQX",
+			"DDJJRRRRX",
+			"EKKKSTTYX",
+			"ELLLSTTYX",
+			"FFMMSUUYZ",
+			"FFNNNNUZZ",
+		},
+		clues,
+	)
+	assert.NoError(t, err)
+	return rules
+}
+
+func TestKillerCage_Clues(t *testing.T) {
+	SudokuTests[sudoku.Digits9, sudoku.Area9x9]{
+		"unknown and bounded totals": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				"        4",
+				"  7      ",
+				"34     98",
+				"      7  ",
+				"         ",
+				"  2      ",
+				"   6     ",
+				"        5",
+				"  952    ",
+			),
+			killerCageClues(t, map[rune]string{
+				'A': "?", 'B': "<23", 'C': ">10", 'D': "13", 'E': "?", 'F': "<21", 'G': ">10", 'H': "9", 'I': "?",
+				'J': "<11", 'K': ">7", 'L': "19", 'M': "?", 'N': "<26", 'O': ">14", 'P': "12", 'Q': "?", 'R': "<17",
+				'S': ">17", 'T': "25", 'U': "?", 'V': "<21", 'W': ">17", 'X': "19", 'Y': "?", 'Z': "<14",
+			}),
+		},
+		"shared totals": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				"         ",
+				"         ",
+				"3      98",
+				"9        ",
+				"         ",
+				"        1",
+				"         ",
+				"        5",
+				"         ",
+			),
+			killerCageClues(t, map[rune]string{
+				'A': "11", 'B': "d", 'C': "b", 'D': "13", 'E': "7", 'F': "c", 'G': "b", 'H': "a", 'I': "e",
+				'J': "a", 'K': "a", 'L': "c", 'M': "b", 'N': "24", 'O': "16", 'P': "b", 'Q': "d", 'R': "15",
+				'S': "c", 'T': "e", 'U': "b", 'V': "c", 'W': "c", 'X': "c", 'Y': "14", 'Z': "b",
+			}),
+		},
+	}.Run(t, sudoku.NewSudokuBuilder9x9)
+}
+
+func TestKillerCage_InvalidClue(t *testing.T) {
+	for _, clue := range []string{"<", ">x", "15+", "ab"} {
+		_, err := extraRule.KillerCageRulesFromClues[sudoku.Digits9, sudoku.Area9x9]([]string{"AA"}, map[rune]string{'A': clue})
+		assert.ErrorIs(t, err, extraRule.ErrInvalidAreaSum, clue)
+	}
+
+	// two cells of digits 1-9 can't add up to less than 2
+	for _, clue := range []string{"<2", "0", "1"} {
+		rules, err := extraRule.KillerCageRulesFromClues[sudoku.Digits9, sudoku.Area9x9]([]string{"AA"}, map[rune]string{'A': clue})
+		assert.NoError(t, err, clue)
+		sb := sudoku.NewSudokuBuilder9x9()
+		assert.ErrorIs(t, sb.Use(rules...), extraRule.ErrInvalidAreaSum, clue)
+	}
+
+	// two cells can't add up to 19
+	sb := sudoku.NewSudokuBuilder9x9()
+	err := sb.Use(extraRule.KillerCageRule[sudoku.Digits9, sudoku.Area9x9]{
+		Area: []sudoku.CellLocation{{Row: 0, Col: 0}, {Row: 0, Col: 1}},
+		Min:  new(19),
+	})
+	assert.ErrorIs(t, err, extraRule.ErrInvalidAreaSum)
+}
+
+func TestKillerCage_ZeroTotal(t *testing.T) {
+	// with digits 0-8, a single cell can add up to 0
+	for _, clue := range []string{"0", "<1"} {
+		rules, err := extraRule.KillerCageRulesFromClues[sudoku.Digits9, sudoku.Area9x9]([]string{"A"}, map[rune]string{'A': clue})
+		assert.NoError(t, err, clue)
+		sb := sudoku.NewSudokuBuilder9x9()
+		assert.NoError(t, sb.Use(rule.AlphabetRule[sudoku.Digits9, sudoku.Area9x9]{Alphabet: sudoku.ZeroBasedAlphabet}), clue)
+		assert.NoError(t, sb.Use(rules...), clue)
+		s, err := sb.Build()
+		assert.NoError(t, err, clue)
+
+		for symbol, valid := range map[rune]bool{'0': true, '1': false} {
+			err := s.Try(func(s sudoku.Sudoku[sudoku.Digits9, sudoku.Area9x9]) error {
+				v, _ := s.Alphabet().Parse(symbol)
+				if err := s.Set(sudoku.CellLocation{Row: 0, Col: 0}, v); err != nil {
+					return err
+				}
+				return s.Validate()
+			})
+			if valid {
+				assert.NoError(t, err, clue)
+			} else {
+				assert.ErrorIs(t, err, extraRule.ErrInvalidAreaSum, clue)
+			}
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package test
+
+import (
+	"testing"
+
+	extraRule "github.com/lumaraf/sudoku-solver/extra/rule"
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+func TestKropki(t *testing.T) {
+	SudokuTests[sudoku.Digits9, sudoku.Area9x9]{
+		"kropki": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			extraRule.KropkiRuleFromString[sudoku.Digits9, sudoku.Area9x9](
+				true,
+				". . . . .o. . . .",
+				"      *   *   o o",
+				".o.o.o. . . . . .",
+				"* *     o o      ",
+				". . .o. . . . .o.",
+				"o   *            ",
+				". . .o. .o. . . .",
+				"    *   o * o    ",
+				". .o. . . .o. . .",
+				"  * o o       *  ",
+				". . . . . . . .o.",
+				"        o        ",
+				". .o. .o. . . .o.",
+				"o         o * *  ",
+				". .o. . .o.*.o. .",
+				"*   * o         o",
+				". . .o. .o. . .o.",
+			),
+		},
+	}.Run(t, sudoku.NewSudokuBuilder9x9)
+}
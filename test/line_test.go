@@ -0,0 +1,179 @@
+package test
+
+import (
+	"testing"
+
+	extraRule "github.com/lumaraf/sudoku-solver/extra/rule"
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+	"github.com/stretchr/testify/assert"
+)
+
+func lineRules(t *testing.T, line extraRule.Line, paths ...string) sudoku.Rules[sudoku.Digits9, sudoku.Area9x9] {
+	rules, err := extraRule.LineRulesFromPaths[sudoku.Digits9, sudoku.Area9x9](line, paths...)
+	assert.NoError(t, err)
+	return rules
+}
+
+func TestLines(t *testing.T) {
+	SudokuTests[sudoku.Digits9, sudoku.Area9x9]{
+		"german whispers": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				"9        ",
+				"  5  2   ",
+				"         ",
+				"      81 ",
+				"         ",
+				"         ",
+				"      26 ",
+				"      4 7",
+				"         ",
+			),
+			lineRules(t, extraRule.GermanWhispers(),
+				"r6c1 r7c1 r7c2 r6c3 r5c4",
+				"r4c1 r3c2 r4c2 r4c3 r3c4",
+				"r8c5 r7c5 r8c6 r7c7 r7c6",
+				"r6c8 r5c7 r4c8 r5c9 r6c9",
+				"r5c3 r6c4 r5c5 r6c6 r6c5",
+				"r1c4 r2c5 r3c6 r3c5 r4c5",
+			),
+		},
+		"dutch whispers": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				" 1       ",
+				"3 5 8    ",
+				" 28   3  ",
+				"   5    6",
+				" 3 8     ",
+				"8        ",
+				"     7   ",
+				"         ",
+				"  32     ",
+			),
+			lineRules(t, extraRule.DutchWhispers(),
+				"r7c5 r6c4 r6c3 r5c4 r4c3",
+				"r9c6 r9c7 r8c6 r7c7 r6c6",
+				"r4c4 r3c4 r4c5 r5c6 r5c5",
+				"r2c9 r3c8 r4c8 r5c7 r6c8",
+				"r7c1 r8c2 r7c3 r8c4 r8c5",
+				"r5c9 r5c8 r4c7 r3c6 r3c5",
+			),
+		},
+		"renban": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				" 1  5    ",
+				"      9  ",
+				"         ",
+				"         ",
+				"   8     ",
+				"       2 ",
+				"         ",
+				"   19    ",
+				"473      ",
+			),
+			lineRules(t, extraRule.Renban{},
+				"r6c7 r7c6 r6c5 r5c6",
+				"r9c8 r9c9 r8c9 r7c8",
+				"r5c2 r4c3 r4c4 r4c5",
+				"r9c7 r8c8 r7c7 r8c7",
+				"r6c9 r7c9 r6c8 r5c8",
+				"r3c9 r4c9 r3c8 r2c8",
+				"r3c3 r3c4 r2c4 r3c5",
+			),
+		},
+		"palindrome": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				"9 7    8 ",
+				" 4  82   ",
+				"62       ",
+				"         ",
+				"  2  6 4 ",
+				"   7    3",
+				"1       5",
+				"    9    ",
+				"    65   ",
+			),
+			lineRules(t, extraRule.Palindrome{},
+				"r4c8 r3c7 r4c6 r5c5",
+				"r5c1 r6c1 r7c2 r8c2",
+				"r5c8 r6c8 r7c7 r8c7",
+				"r7c3 r7c4 r6c5 r6c6",
+			),
+		},
+		"entropic": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				" 1       ",
+				"      97 ",
+				"      3  ",
+				"    2    ",
+				"   8 6 4 ",
+				" 6   9   ",
+				"         ",
+				"2       7",
+				"4 3      ",
+			),
+			lineRules(t, extraRule.EntropicLine{},
+				"r6c8 r6c7 r7c6 r7c7 r8c7 r9c8",
+				"r6c2 r5c2 r4c1 r3c1 r2c1 r1c1",
+				"r9c7 r9c6 r8c5 r9c4 r8c3 r9c2",
+				"r2c5 r1c6 r2c6 r2c7 r3c8 r2c9",
+				"r9c1 r8c1 r7c2 r8c2 r7c1 r6c1",
+			),
+		},
+	}.Run(t, sudoku.NewSudokuBuilder9x9)
+}
+
+func TestLines_Invalid(t *testing.T) {
+	sb := sudoku.NewSudokuBuilder8x8()
+	assert.ErrorIs(t, sb.Use(extraRule.LineRule[sudoku.Digits8, sudoku.Area8x8]{
+		Path: []sudoku.CellLocation{{Row: 0, Col: 0}, {Row: 0, Col: 1}, {Row: 0, Col: 2}},
+		Line: extraRule.EntropicLine{},
+	}), extraRule.ErrInvalidLine)
+
+	sb = sudoku.NewSudokuBuilder8x8()
+	assert.ErrorIs(t, sb.Use(extraRule.LineRule[sudoku.Digits8, sudoku.Area8x8]{
+		Path: []sudoku.CellLocation{{Row: 0, Col: 0}, {Row: 0, Col: 2}},
+		Line: extraRule.Renban{},
+	}), extraRule.ErrInvalidLine)
+}
+
+// lowLine is a set line that contains the lowest digits.
+type lowLine struct{}
+
+func (l lowLine) Name() string {
+	return "low"
+}
+
+func (l lowLine) Check(size, length int) error {
+	return nil
+}
+
+func (l lowLine) Related(size, length, i, j, v1, v2 int) bool {
+	return v1 != v2
+}
+
+func (l lowLine) Sets(size, length int) [][]int {
+	set := make([]int, 0, length)
+	for v := 1; v <= length; v++ {
+		set = append(set, v)
+	}
+	return [][]int{set}
+}
+
+func TestLines_SetLine(t *testing.T) {
+	sb := sudoku.NewSudokuBuilder9x9()
+	assert.NoError(t, sb.Use(extraRule.LineRule[sudoku.Digits9, sudoku.Area9x9]{
+		Path: []sudoku.CellLocation{{Row: 0, Col: 0}, {Row: 1, Col: 1}, {Row: 2, Col: 2}},
+		Line: lowLine{},
+	}))
+	s, err := sb.Build()
+	assert.NoError(t, err)
+	for _, l := range []sudoku.CellLocation{{Row: 0, Col: 0}, {Row: 1, Col: 1}, {Row: 2, Col: 2}} {
+		assert.Equal(t, s.NewDigits(1, 2, 3), s.Get(l))
+	}
+}
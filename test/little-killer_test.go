@@ -0,0 +1,52 @@
+package test
+
+import (
+	"testing"
+
+	extraRule "github.com/lumaraf/sudoku-solver/extra/rule"
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLittleKiller(t *testing.T) {
+	littleKillers, err := extraRule.LittleKillerRulesFromStrings[sudoku.Digits9, sudoku.Area9x9](
+		"r10c4 ne 27",
+		"r5c10 sw 18",
+		"r0c8 sw 29",
+		"r10c9 nw 41",
+		"r0c5 sw 17",
+		"r0c6 sw 33",
+		"r8c10 nw 37",
+		"r6c10 sw 9",
+	)
+	assert.NoError(t, err)
+
+	SudokuTests[sudoku.Digits9, sudoku.Area9x9]{
+		"little killer": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				"   3     ",
+				"345      ",
+				"     1 5 ",
+				" 9  2  1 ",
+				"5  8  7  ",
+				"   7     ",
+				"   4     ",
+				" 5    4 7",
+				"         ",
+			),
+			littleKillers,
+		},
+	}.Run(t, sudoku.NewSudokuBuilder9x9)
+}
+
+func TestLittleKiller_Invalid(t *testing.T) {
+	_, err := extraRule.LittleKillerRulesFromStrings[sudoku.Digits9, sudoku.Area9x9]("r0c3 down 12")
+	assert.ErrorIs(t, err, extraRule.ErrInvalidLittleKiller)
+
+	littleKillers, err := extraRule.LittleKillerRulesFromStrings[sudoku.Digits9, sudoku.Area9x9]("r1c3 se 12")
+	assert.NoError(t, err)
+	sb := sudoku.NewSudokuBuilder9x9()
+	assert.ErrorIs(t, sb.Use(littleKillers), extraRule.ErrInvalidLittleKiller)
+}
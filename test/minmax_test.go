@@ -0,0 +1,105 @@
+package test
+
+import (
+	"testing"
+
+	extraRule "github.com/lumaraf/sudoku-solver/extra/rule"
+	extraStrategy "github.com/lumaraf/sudoku-solver/extra/strategy"
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinMax(t *testing.T) {
+	SudokuTests[sudoku.Digits9, sudoku.Area9x9]{
+		"minimum maximum": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				"         ",
+				"         ",
+				"3        ",
+				"7        ",
+				"  5      ",
+				"         ",
+				" 5 6     ",
+				"       4 ",
+				"   3     ",
+			),
+			extraRule.MinMaxRuleFromString[sudoku.Digits9, sudoku.Area9x9](
+				"LH   HL H",
+				"H  L  H L",
+				" L H H L ",
+				"   LHL  H",
+				"HL H H  L",
+				"L HL  L H",
+				"    H  L ",
+				"H L  L  H",
+				"L   L  HL",
+			),
+		},
+		"fortress": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				"       24",
+				" 4   5   ",
+				"31     5 ",
+				"     1   ",
+				"  5 67   ",
+				"        8",
+				"        2",
+				"    7  46",
+				"  63   9 ",
+			),
+			extraRule.FortressRuleFromString[sudoku.Digits9, sudoku.Area9x9](
+				" FF      ",
+				"      FF ",
+				"   F F   ",
+				"    F   F",
+				"F  F     ",
+				"  F     F",
+				"    FF   ",
+				"FF       ",
+				"      FF ",
+			),
+		},
+	}.Run(t, sudoku.NewSudokuBuilder9x9)
+}
+
+func TestMinMax_Invalid(t *testing.T) {
+	for name, rows := range map[string][]string{
+		"adjacent maxima": {"HH"},
+		"adjacent minima": {"L", "L"},
+	} {
+		sb := sudoku.NewSudokuBuilder9x9()
+		err := sb.Use(extraRule.MinMaxRuleFromString[sudoku.Digits9, sudoku.Area9x9](rows...))
+		assert.ErrorIs(t, err, extraRule.ErrInvalidMinMax, name)
+	}
+
+	sb := sudoku.NewSudokuBuilder9x9()
+	err := sb.Use(extraRule.MinMaxRule[sudoku.Digits9, sudoku.Area9x9]{
+		Maxima: []sudoku.CellLocation{{Row: 0, Col: 0}},
+		Minima: []sudoku.CellLocation{{Row: 0, Col: 0}},
+	})
+	assert.ErrorIs(t, err, extraRule.ErrInvalidMinMax)
+}
+
+func TestMinMax_InequalityChain(t *testing.T) {
+	// r1c1 is smaller than r1c2 by the sign and smaller than the maximum r2c1, which sees r1c2
+	s, err := sudoku.NewSudoku9x9(
+		rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+		extraRule.InequalityRuleFromString[sudoku.Digits9, sudoku.Area9x9](
+			".<.",
+		),
+		extraRule.MinMaxRuleFromString[sudoku.Digits9, sudoku.Area9x9](
+			" ",
+			"H",
+		),
+	)
+	assert.NoError(t, err)
+
+	slv := s.NewSolver()
+	slv.Use(sudoku.StrategyFactoryFunc[sudoku.Digits9, sudoku.Area9x9](extraStrategy.InequalityStrategyFactory[sudoku.Digits9, sudoku.Area9x9]))
+	slv.SetChainLimit(0)
+	assert.NoError(t, slv.Solve(t.Context()))
+	assert.False(t, s.Get(sudoku.CellLocation{Row: 0, Col: 0}).CanContain(8))
+}
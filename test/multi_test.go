@@ -7,6 +7,7 @@ import (
 	"github.com/lumaraf/sudoku-solver/sudoku"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestMulti(t *testing.T) {
@@ -49,3 +50,128 @@ func TestMulti(t *testing.T) {
 	assert.NoError(t, m.Solve(context.Background(), strategy.AllStrategies[sudoku.Digits9, sudoku.Area9x9]()))
 	assert.True(t, m.IsSolved())
 }
+
+func TestSamurai(t *testing.T) {
+	mb, err := sudoku.NewSamuraiBuilder(sudoku.NewSudokuBuilder9x9)
+	assert.NoError(t, err)
+	assert.NoError(t, mb.Use(rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{}))
+	assert.NoError(t, mb.SetGivenDigits(
+		"4........   ......4.3",
+		"..21.8.6.   ..8.6..5.",
+		".9...3...   ...75....",
+		".3....98.   .2...46..",
+		".....4.3.   9....7.2.",
+		"9.4..75..   .3......4",
+		"3.5...8.......9...3..",
+		"2......7.2.9.......19",
+		".4...................",
+		"      .........",
+		"      75...8...",
+		"      1..46...2",
+		"...9...375.1..4......",
+		".8...7.2.9...3.......",
+		"........46.75.....637",
+		"....4...5   ....7.2..",
+		"8........   3....9..6",
+		"375...8.6   .1..46...",
+		"...46.7.2   .....219.",
+		"..3...1..   ...1..4.3",
+		".52......   ....6....",
+	))
+
+	m, err := mb.Build()
+	assert.NoError(t, err)
+	assert.NoError(t, m.Solve(context.Background(), strategy.AllStrategies[sudoku.Digits9, sudoku.Area9x9]()))
+	assert.True(t, m.IsSolved())
+	m.Print()
+}
+
+func TestSamuraiGuesser(t *testing.T) {
+	mb, err := sudoku.NewSamuraiBuilder(sudoku.NewSudokuBuilder9x9)
+	assert.NoError(t, err)
+	assert.NoError(t, mb.Use(rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{}))
+	// the samurai puzzle from above without the givens of the centre grid
+	assert.NoError(t, mb.SetGivenDigits(
+		"4........   ......4.3",
+		"..21.8.6.   ..8.6..5.",
+		".9...3...   ...75....",
+		".3....98.   .2...46..",
+		".....4.3.   9....7.2.",
+		"9.4..75..   .3......4",
+		"3.5...8.......9...3..",
+		"2......7.2.9.......19",
+		".4...................",
+		"      .........",
+		"      .........",
+		"      .........",
+		"...9...375.1..4......",
+		".8...7.2.9...3.......",
+		"........46.75.....637",
+		"....4...5   ....7.2..",
+		"8........   3....9..6",
+		"375...8.6   .1..46...",
+		"...46.7.2   .....219.",
+		"..3...1..   ...1..4.3",
+		".52......   ....6....",
+	))
+
+	m, err := mb.Build()
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+
+	g := m.NewGuesser()
+	// the pattern overlay is too slow for the mostly empty centre grid
+	g.Use(
+		sudoku.StrategyFactoryFunc[sudoku.Digits9, sudoku.Area9x9](strategy.UniqueSetStrategyFactory[sudoku.Digits9, sudoku.Area9x9]),
+		sudoku.StrategyFactoryFunc[sudoku.Digits9, sudoku.Area9x9](strategy.LogicChainStrategyFactory[sudoku.Digits9, sudoku.Area9x9]),
+	)
+	solutions := 0
+	for solution := range g.Guess(nil, ctx) {
+		assert.True(t, solution.IsSolved())
+		assert.Greater(t, solution.Stats().GuesserRuns, 0)
+		solutions++
+		if solutions == 2 {
+			break
+		}
+	}
+	assert.Equal(t, 2, solutions)
+	assert.False(t, m.IsSolved())
+	assert.Equal(t, 0, m.Stats().GuesserRuns)
+}
+
+func TestKazaguruma(t *testing.T) {
+	mb, err := sudoku.NewKazagurumaBuilder(sudoku.NewSudokuBuilder9x9)
+	assert.NoError(t, err)
+	assert.NoError(t, mb.Use(rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{}))
+	assert.NoError(t, mb.SetGivenDigits(
+		"   .5.19....",
+		"   ..8.....2",
+		"   .6.7..1.8",
+		"   ..19.....5....4..7",
+		"   .8...752..8.6....1",
+		"   .......8.....2....",
+		"   ..9.........8...75",
+		"   .......1...63.....",
+		"   ......8.6.7.......",
+		"7........75....4.....",
+		".9......2.9......2...",
+		"..3.5....4....2.9....",
+		"...984......9...3.",
+		"........1.8.......",
+		"6..........7......",
+		"2...46.....9...3..",
+		".4.37...9....75...",
+		".........3....984.",
+		"         1..46....",
+		"         ......1.8",
+		"         .52......",
+	))
+
+	m, err := mb.Build()
+	assert.NoError(t, err)
+	assert.NoError(t, m.Solve(context.Background(), strategy.AllStrategies[sudoku.Digits9, sudoku.Area9x9]()))
+	assert.True(t, m.IsSolved())
+	m.Print()
+}
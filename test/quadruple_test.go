@@ -0,0 +1,90 @@
+package test
+
+import (
+	"testing"
+
+	extraRule "github.com/lumaraf/sudoku-solver/extra/rule"
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuadruple(t *testing.T) {
+	quadruples, err := extraRule.QuadrupleRulesFromStrings[sudoku.Digits9, sudoku.Area9x9](
+		"r1c8 128",
+		"r5c6 5679",
+		"r5c2 236",
+		"r1c3 67",
+		"r2c7 3579",
+		"r6c1 168",
+		"r7c5 3789",
+		"r6c5 79",
+		"r8c5 5689",
+		"r7c6 27",
+		"r1c4 3568",
+		"r8c7 14",
+		"r8c8 3789",
+		"r1c2 457",
+	)
+	assert.NoError(t, err)
+
+	SudokuTests[sudoku.Digits9, sudoku.Area9x9]{
+		"quadruple": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				"        2",
+				"         ",
+				"     1   ",
+				"    2    ",
+				"       4 ",
+				"   7     ",
+				" 8       ",
+				"         ",
+				"4        ",
+			),
+			quadruples,
+		},
+	}.Run(t, sudoku.NewSudokuBuilder9x9)
+}
+
+func TestQuadruple_Invalid(t *testing.T) {
+	for _, clue := range []string{"r1c1 12345", "r9c1 12", "r1c1 0"} {
+		quadruples, err := extraRule.QuadrupleRulesFromStrings[sudoku.Digits9, sudoku.Area9x9](clue)
+		assert.NoError(t, err)
+		sb := sudoku.NewSudokuBuilder9x9()
+		assert.ErrorIs(t, sb.Use(quadruples), extraRule.ErrInvalidQuadruple, clue)
+	}
+}
+
+func TestQuadruple_Alphabet(t *testing.T) {
+	quadruples, err := extraRule.QuadrupleRulesFromStrings[sudoku.Digits16, sudoku.Area16x16]("r1c1 0AF", "r3c3 0 10 15")
+	assert.NoError(t, err)
+	sb := sudoku.NewSudokuBuilder16x16()
+	assert.NoError(t, sb.Use(
+		rule.AlphabetRule[sudoku.Digits16, sudoku.Area16x16]{Alphabet: sudoku.ZeroBasedAlphabet},
+		rule.ClassicRules[sudoku.Digits16, sudoku.Area16x16]{},
+		quadruples,
+	))
+	s, err := sb.Build()
+	assert.NoError(t, err)
+
+	// both quadruples need 0, A and F, which are the digit values 1, 11 and 16
+	count := 0
+	for q := range sudoku.GetRestrictions[sudoku.Digits16, sudoku.Area16x16, extraRule.QuadrupleRestriction[sudoku.Digits16, sudoku.Area16x16]](s) {
+		assert.Equal(t, map[int]int{1: 1, 11: 1, 16: 1}, q.Counts())
+		count++
+	}
+	assert.Equal(t, 2, count)
+}
+
+func TestQuadruple_RepeatedDigit(t *testing.T) {
+	quadruples, err := extraRule.QuadrupleRulesFromStrings[sudoku.Digits9, sudoku.Area9x9]("r1c3 33")
+	assert.NoError(t, err)
+	sb := sudoku.NewSudokuBuilder9x9()
+	assert.NoError(t, sb.Use(rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{}, quadruples))
+	assert.NoError(t, sb.SetCell(0, 2, 1))
+	s, err := sb.Build()
+	assert.NoError(t, err)
+	assert.Equal(t, s.NewDigits(3), s.Get(sudoku.CellLocation{Row: 0, Col: 3}))
+	assert.Equal(t, s.NewDigits(3), s.Get(sudoku.CellLocation{Row: 1, Col: 2}))
+}
@@ -0,0 +1,67 @@
+package test
+
+import (
+	"testing"
+
+	extraRule "github.com/lumaraf/sudoku-solver/extra/rule"
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegionSumLine(t *testing.T) {
+	lines, err := extraRule.RegionSumLineRulesFromPaths[sudoku.Digits9, sudoku.Area9x9](
+		"r8c2 r7c3 r6c2 r5c1 r5c2",
+		"r8c3 r9c3 r9c4 r8c4 r9c5",
+		"r4c9 r5c8 r6c7 r6c6 r5c6",
+		"r9c8 r9c7 r8c7 r9c6 r8c5",
+		"r1c8 r2c7 r2c6 r3c5 r2c5",
+		"r8c9 r7c8 r7c7 r8c6 r7c6",
+		"r1c6 r1c5 r1c4 r1c3 r2c3",
+		"r2c1 r2c2 r3c3 r3c4 r2c4",
+	)
+	assert.NoError(t, err)
+
+	SudokuTests[sudoku.Digits9, sudoku.Area9x9]{
+		"region sum line": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				"        2",
+				"3        ",
+				"   9     ",
+				"       1 ",
+				"    16 4 ",
+				"8        ",
+				"  9  7 6 ",
+				"2        ",
+				"   2 5   ",
+			),
+			lines,
+		},
+	}.Run(t, sudoku.NewSudokuBuilder9x9)
+}
+
+func TestRegionSumLine_SingleBox(t *testing.T) {
+	lines, err := extraRule.RegionSumLineRulesFromPaths[sudoku.Digits9, sudoku.Area9x9]("r1c1 r1c2 r1c3")
+	assert.NoError(t, err)
+	sb := sudoku.NewSudokuBuilder9x9()
+	assert.ErrorIs(t, sb.Use(lines), extraRule.ErrInvalidRegionSumLine)
+}
+
+func TestRegionSumLine_RepeatedDigits(t *testing.T) {
+	lines, err := extraRule.RegionSumLineRulesFromPaths[sudoku.Digits9, sudoku.Area9x9]("r1c2 r2c3 r2c4")
+	assert.NoError(t, err)
+
+	// without boxes, the two cells of the first segment may both contain a 1
+	s, err := sudoku.NewSudoku9x9(
+		rule.LatinSquareRules[sudoku.Digits9, sudoku.Area9x9]{},
+		lines,
+		rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+			"         ",
+			"   2     ",
+		),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, s.NewDigits(1), s.Get(sudoku.CellLocation{Row: 0, Col: 1}))
+	assert.Equal(t, s.NewDigits(1), s.Get(sudoku.CellLocation{Row: 1, Col: 2}))
+}
@@ -0,0 +1,88 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+func TestExtraRegions(t *testing.T) {
+	SudokuTests[sudoku.Digits9, sudoku.Area9x9]{
+		"windoku": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				"38      7",
+				"972     1",
+				"       4 ",
+				"     5   ",
+				"    1    ",
+				"  1     2",
+				" 3 2     ",
+				"      1 3",
+				"  5 8    ",
+			),
+			rule.WindokuRule[sudoku.Digits9, sudoku.Area9x9]{},
+		},
+		"asterisk": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				" 2      4",
+				"453      ",
+				"       91",
+				"     2   ",
+				"    7    ",
+				"8 76    2",
+				"   42   9",
+				"        6",
+				"  8756   ",
+			),
+			rule.AsteriskRule[sudoku.Digits9, sudoku.Area9x9]{},
+		},
+		"centre dot": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				" 9      4",
+				"231      ",
+				" 6 8   31",
+				"4    6 2 ",
+				"    5    ",
+				"7 61    3",
+				" 1 3     ",
+				"    7 9 8",
+				"  5      ",
+			),
+			rule.CentreDotRule[sudoku.Digits9, sudoku.Area9x9]{},
+		},
+		"argyle": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				" 8       ",
+				"217      ",
+				"5       4",
+				"     3   ",
+				"         ",
+				"  3     9",
+				" 2 7     ",
+				"        5",
+				"  9 4    ",
+			),
+			rule.ArgyleRule[sudoku.Digits9, sudoku.Area9x9]{},
+		},
+		"girandola": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				" 3       ",
+				"  1  4   ",
+				"56   1 2 ",
+				"1    6   ",
+				"    8    ",
+				"7 9     5",
+				"  23     ",
+				"    7   1",
+				"  89 2   ",
+			),
+			rule.GirandolaRule[sudoku.Digits9, sudoku.Area9x9]{},
+		},
+	}.Run(t, sudoku.NewSudokuBuilder9x9)
+}
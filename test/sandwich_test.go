@@ -0,0 +1,49 @@
+package test
+
+import (
+	"testing"
+
+	extraRule "github.com/lumaraf/sudoku-solver/extra/rule"
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSandwich(t *testing.T) {
+	const x = extraRule.SandwichNoClue
+	SudokuTests[sudoku.Digits9, sudoku.Area9x9]{
+		"sandwich": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				" 1   4   ",
+				"    8  7 ",
+				"         ",
+				"         ",
+				"5        ",
+				"         ",
+				"       6 ",
+				"      4 7",
+				"         ",
+			),
+			extraRule.SandwichRule[sudoku.Digits9, sudoku.Area9x9]{
+				Rows:    []int{0, x, 7, 22, 17, x, 8, x, 0},
+				Columns: []int{29, 6, 0, x, x, 9, 29, x, 10},
+			},
+		},
+	}.Run(t, sudoku.NewSudokuBuilder9x9)
+}
+
+func TestSandwich_InvalidSum(t *testing.T) {
+	sb := sudoku.NewSudokuBuilder9x9()
+	assert.ErrorIs(t, sb.Use(extraRule.SandwichRule[sudoku.Digits9, sudoku.Area9x9]{
+		Rows: []int{36},
+	}), extraRule.ErrInvalidSandwich)
+}
+
+func TestSandwich_ZeroBasedAlphabet(t *testing.T) {
+	sb := sudoku.NewSudokuBuilder9x9()
+	assert.ErrorIs(t, sb.Use(
+		rule.AlphabetRule[sudoku.Digits9, sudoku.Area9x9]{Alphabet: sudoku.ZeroBasedAlphabet},
+		extraRule.SandwichRule[sudoku.Digits9, sudoku.Area9x9]{Rows: []int{10}},
+	), sudoku.ErrUnsupportedAlphabet)
+}
@@ -0,0 +1,43 @@
+package test
+
+import (
+	"testing"
+
+	extraRule "github.com/lumaraf/sudoku-solver/extra/rule"
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSkyscraper(t *testing.T) {
+	SudokuTests[sudoku.Digits9, sudoku.Area9x9]{
+		"skyscraper": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				"         ",
+				"         ",
+				" 2      4",
+				"   52    ",
+				"       4 ",
+				"   74    ",
+				"    3  6 ",
+				"2        ",
+				"      1  ",
+			),
+			extraRule.SkyscraperRule[sudoku.Digits9, sudoku.Area9x9]{
+				OutsideClues: extraRule.OutsideClues{
+					Top:    []int{0, 3, 3, 3, 3, 0, 2, 2, 0},
+					Bottom: []int{3, 3, 3, 5, 0, 3, 6, 0, 2},
+					Left:   []int{0, 6, 3, 0, 3, 0, 0, 0, 0},
+					Right:  []int{3, 0, 0, 0, 1, 0, 4, 3, 0},
+				},
+			},
+		},
+	}.Run(t, sudoku.NewSudokuBuilder9x9)
+}
+
+func TestVisibleSkyscrapers(t *testing.T) {
+	assert.Equal(t, 1, extraRule.VisibleSkyscrapers([]int{9, 1, 2, 3, 4, 5, 6, 7, 8}))
+	assert.Equal(t, 9, extraRule.VisibleSkyscrapers([]int{1, 2, 3, 4, 5, 6, 7, 8, 9}))
+	assert.Equal(t, 3, extraRule.VisibleSkyscrapers([]int{3, 1, 5, 2, 9, 4, 6, 7, 8}))
+}
@@ -0,0 +1,56 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+)
+
+func Test4x4(t *testing.T) {
+	SudokuTests[sudoku.Digits4, sudoku.Area4x4]{
+		"mini": {
+			rule.ClassicRules[sudoku.Digits4, sudoku.Area4x4]{},
+			rule.GivenDigitsFromString[sudoku.Digits4, sudoku.Area4x4](
+				"    ",
+				" 2 1",
+				" 3  ",
+				"   4",
+			),
+		},
+	}.Run(t, sudoku.NewSudokuBuilder4x4)
+}
+
+func Test6x6(t *testing.T) {
+	SudokuTests[sudoku.Digits6, sudoku.Area6x6]{
+		"classic": {
+			rule.ClassicRules[sudoku.Digits6, sudoku.Area6x6]{},
+			rule.GivenDigitsFromString[sudoku.Digits6, sudoku.Area6x6](
+				"  5  2",
+				"6     ",
+				"4    5",
+				"5   4 ",
+				"  12  ",
+				"     1",
+			),
+		},
+	}.Run(t, sudoku.NewSudokuBuilder6x6)
+}
+
+func Test8x8(t *testing.T) {
+	SudokuTests[sudoku.Digits8, sudoku.Area8x8]{
+		"classic": {
+			rule.ClassicRules[sudoku.Digits8, sudoku.Area8x8]{},
+			rule.GivenDigitsFromString[sudoku.Digits8, sudoku.Area8x8](
+				" 75    1",
+				"    3   ",
+				"  6    3",
+				"82      ",
+				"  7 6  2",
+				" 4    7 ",
+				"21     8",
+				"  4    7",
+			),
+		},
+	}.Run(t, sudoku.NewSudokuBuilder8x8)
+}
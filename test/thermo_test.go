@@ -0,0 +1,51 @@
+package test
+
+import (
+	"testing"
+
+	extraRule "github.com/lumaraf/sudoku-solver/extra/rule"
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThermo(t *testing.T) {
+	thermos, err := extraRule.ThermoRulesFromPaths[sudoku.Digits9, sudoku.Area9x9](
+		"r9c6 r8c6 r8c5",
+		"r4c8 r5c8 r5c7 r4c7",
+		"r9c7 r8c8 r8c9 r9c8",
+		"r2c8 r1c8 r2c7",
+		"r5c2 r4c1 r4c2",
+		"r4c5 r5c6 r6c6",
+		"r2c6 r1c6 r1c7",
+	)
+	assert.NoError(t, err)
+
+	SudokuTests[sudoku.Digits9, sudoku.Area9x9]{
+		"thermo": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				"     4 8 ",
+				"3       1",
+				"    7 3  ",
+				"         ",
+				"   8  7  ",
+				" 61 4    ",
+				"  9 37   ",
+				"2        ",
+				"   2    8",
+			),
+			thermos,
+		},
+	}.Run(t, sudoku.NewSudokuBuilder9x9)
+}
+
+func TestThermo_InvalidPath(t *testing.T) {
+	_, err := extraRule.ThermoRulesFromPaths[sudoku.Digits9, sudoku.Area9x9]("r1c1 x")
+	assert.ErrorIs(t, err, extraRule.ErrInvalidCellPath)
+
+	thermos, err := extraRule.ThermoRulesFromPaths[sudoku.Digits9, sudoku.Area9x9]("r1c1 r1c3")
+	assert.NoError(t, err)
+	sb := sudoku.NewSudokuBuilder9x9()
+	assert.ErrorIs(t, sb.Use(thermos), extraRule.ErrInvalidThermo)
+}
@@ -0,0 +1,41 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordoku(t *testing.T) {
+	SudokuTests[sudoku.Digits9, sudoku.Area9x9]{
+		"wordoku": {
+			rule.AlphabetRule[sudoku.Digits9, sudoku.Area9x9]{Alphabet: sudoku.NewAlphabet("WORDSHAPE", 1)},
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				" R       ",
+				"   WES   ",
+				"  P    H ",
+				"P   H    ",
+				"D  P    W",
+				"    O    ",
+				" H    OP ",
+				"   DWE  S",
+				"       A ",
+			),
+		},
+	}.Run(t, sudoku.NewSudokuBuilder9x9)
+}
+
+func TestWordoku_InvalidAlphabet(t *testing.T) {
+	for name, symbols := range map[string]string{
+		"too short":  "WORD",
+		"duplicates": "SUDOKUING",
+		"space":      "WORD SHAP",
+	} {
+		sb := sudoku.NewSudokuBuilder9x9()
+		err := sb.Use(rule.AlphabetRule[sudoku.Digits9, sudoku.Area9x9]{Alphabet: sudoku.NewAlphabet(symbols, 1)})
+		assert.ErrorIs(t, err, sudoku.ErrInvalidAlphabet, name)
+	}
+}
@@ -0,0 +1,45 @@
+package test
+
+import (
+	"testing"
+
+	extraRule "github.com/lumaraf/sudoku-solver/extra/rule"
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestXSums(t *testing.T) {
+	SudokuTests[sudoku.Digits9, sudoku.Area9x9]{
+		"x-sums": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				"         ",
+				"         ",
+				"         ",
+				"    2    ",
+				"         ",
+				"         ",
+				"         ",
+				"         ",
+				"         ",
+			),
+			extraRule.XSumsRule[sudoku.Digits9, sudoku.Area9x9]{
+				OutsideClues: extraRule.OutsideClues{
+					Top:    []int{0, 1, 36, 18, 23, 0, 38, 36, 0},
+					Bottom: []int{15, 40, 18, 3, 0, 35, 1, 0, 43},
+					Left:   []int{0, 12, 33, 0, 19, 0, 0, 0, 0},
+					Right:  []int{10, 0, 0, 0, 45, 0, 23, 38, 0},
+				},
+			},
+		},
+	}.Run(t, sudoku.NewSudokuBuilder9x9)
+}
+
+func TestXSums_InvalidClue(t *testing.T) {
+	sb := sudoku.NewSudokuBuilder9x9()
+	err := sb.Use(extraRule.XSumsRule[sudoku.Digits9, sudoku.Area9x9]{
+		OutsideClues: extraRule.OutsideClues{Top: []int{46}},
+	})
+	assert.ErrorIs(t, err, extraRule.ErrInvalidOutsideClue)
+}
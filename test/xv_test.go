@@ -0,0 +1,57 @@
+package test
+
+import (
+	"testing"
+
+	extraRule "github.com/lumaraf/sudoku-solver/extra/rule"
+	"github.com/lumaraf/sudoku-solver/rule"
+	"github.com/lumaraf/sudoku-solver/sudoku"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestXV(t *testing.T) {
+	SudokuTests[sudoku.Digits9, sudoku.Area9x9]{
+		"xv": {
+			rule.ClassicRules[sudoku.Digits9, sudoku.Area9x9]{},
+			rule.GivenDigitsFromString[sudoku.Digits9, sudoku.Area9x9](
+				"         ",
+				"       7 ",
+				"         ",
+				"         ",
+				"        9",
+				"         ",
+				"         ",
+				"         ",
+				"         ",
+			),
+			extraRule.XVRuleFromString[sudoku.Digits9, sudoku.Area9x9](
+				true,
+				".X. .X. . .X. .X.",
+				"  V              ",
+				". . . . .X. . . .",
+				"                V",
+				". .X. . . . . . .",
+				"                X",
+				". . . . .V. . . .",
+				"              V  ",
+				". .V.X. . . . . .",
+				"        V        ",
+				". . . . . . . .V.",
+				"    X            ",
+				". . . . .X. . . .",
+				"      V          ",
+				". . . .X. . . .X.",
+				"            V    ",
+				". .X.V. . . .X. .",
+			),
+		},
+	}.Run(t, sudoku.NewSudokuBuilder9x9)
+}
+
+func TestXV_InvalidEdge(t *testing.T) {
+	sb := sudoku.NewSudokuBuilder9x9()
+	err := sb.Use(extraRule.XVRule[sudoku.Digits9, sudoku.Area9x9]{
+		X: []extraRule.Edge{{A: sudoku.CellLocation{Row: 0, Col: 0}, B: sudoku.CellLocation{Row: 1, Col: 1}}},
+	})
+	assert.ErrorIs(t, err, extraRule.ErrInvalidEdge)
+}